@@ -0,0 +1,293 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// defaultAuthorBooksSort lists an author's books in publication order.
+const defaultAuthorBooksSort = "release_year"
+
+// authorCmd represents the author command.
+var authorCmd = &cobra.Command{
+	Use:   "author",
+	Short: "Look up authors and their books",
+	Long: `Commands for looking up authors in the Hardcover database.
+
+Available subcommands:
+  show     Show an author's profile
+  books    List an author's books`,
+}
+
+// authorShowCmd represents the author show command.
+var authorShowCmd = &cobra.Command{
+	Use:   "show <id|slug>",
+	Short: "Show an author's profile",
+	Long: `Show an author's profile, identified by Hardcover ID or URL slug.
+
+The output includes:
+- Name and alternate names
+- Birth and death years
+- Location
+- Number of books and readers
+- Biography
+
+Example:
+  hardcover author show 80626
+  hardcover author show j-r-r-tolkien`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		var author *client.AuthorDetail
+		if id, atoiErr := strconv.Atoi(args[0]); atoiErr == nil {
+			author, err = gqlClient.GetAuthor(context.Background(), id)
+		} else {
+			author, err = gqlClient.GetAuthorBySlug(context.Background(), args[0])
+		}
+		if errors.Is(err, client.ErrNotFound) {
+			return fmt.Errorf("author %q %w", args[0], client.ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get author: %w", err)
+		}
+
+		view := newAuthorView(author)
+		return render(cmd, view, func(w io.Writer) {
+			printAuthorView(w, view)
+		})
+	},
+}
+
+// authorBooksCmd represents the author books command.
+var authorBooksCmd = &cobra.Command{
+	Use:   "books <id|slug>",
+	Short: "List an author's books",
+	Long: `List every book an author contributed to, with their role, the book's
+release year and its rating.
+
+Filters:
+  --role    Only contributions in this role, e.g. "Author" (primary
+            authorship), "Translator", "Illustrator" or "Editor"
+
+Sorting:
+  --sort    release_year, rating, title or users_count, with an optional :asc
+            or :desc (default release_year, oldest first)
+
+Example:
+  hardcover author books 80626
+  hardcover author books j-r-r-tolkien --role author --sort rating:desc
+  hardcover author books 80626 --role illustrator -o csv`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter, err := contributionFilter(cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		contributions, err := gqlClient.GetContributions(context.Background(), filter)
+		if err != nil {
+			return fmt.Errorf("failed to get author's books: %w", err)
+		}
+
+		views := make([]authorBookView, 0, len(contributions))
+		for i := range contributions {
+			if contributions[i].Book != nil {
+				views = append(views, newAuthorBookView(&contributions[i]))
+			}
+		}
+		return render(cmd, views, func(w io.Writer) {
+			printAuthorBookViews(w, contributions, views)
+		})
+	},
+}
+
+// contributionFilter builds the client filter from the author identifier and
+// the command's flags.
+func contributionFilter(cmd *cobra.Command, author string) (*client.ContributionFilter, error) {
+	filter := &client.ContributionFilter{}
+	if id, err := strconv.Atoi(author); err == nil {
+		filter.AuthorID = id
+	} else {
+		filter.AuthorSlug = author
+	}
+
+	if err := readStringFlags(cmd, map[string]*string{"role": &filter.Role}); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if filter.OrderBy, filter.Descending, err = sortFlag(cmd, defaultAuthorBooksSort); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// authorView is the structured form of the author show command's output.
+type authorView struct {
+	ID             int      `json:"id"`
+	Name           string   `json:"name"`
+	Slug           string   `json:"slug"`
+	URL            string   `json:"url"`
+	AlternateNames []string `json:"alternate_names"`
+	BornYear       int      `json:"born_year"`
+	DeathYear      int      `json:"death_year"`
+	Location       string   `json:"location"`
+	BooksCount     int      `json:"books_count"`
+	UsersCount     int      `json:"users_count"`
+	Bio            string   `json:"bio"`
+}
+
+// newAuthorView converts a client author into its output representation.
+func newAuthorView(author *client.AuthorDetail) *authorView {
+	view := &authorView{
+		ID:             author.ID,
+		Name:           author.Name,
+		Slug:           author.Slug,
+		AlternateNames: author.AlternateNames,
+		BornYear:       author.BornYear,
+		DeathYear:      author.DeathYear,
+		Location:       author.Location,
+		BooksCount:     author.BooksCount,
+		UsersCount:     author.UsersCount,
+		Bio:            strings.TrimSpace(author.Bio),
+	}
+	if author.Slug != "" {
+		view.URL = "https://hardcover.app/authors/" + author.Slug
+	}
+	return view
+}
+
+// printAuthorView writes the human-readable author profile.
+func printAuthorView(w io.Writer, author *authorView) {
+	printToStdoutf(w, "%s\n\n", author.Name)
+	if len(author.AlternateNames) > 0 {
+		printToStdoutf(w, "  Also known as: %s\n", strings.Join(author.AlternateNames, ", "))
+	}
+	if lifespan := formatLifespan(author.BornYear, author.DeathYear); lifespan != "" {
+		printToStdoutf(w, "  %s\n", lifespan)
+	}
+	if author.Location != "" {
+		printToStdoutf(w, "  Location: %s\n", author.Location)
+	}
+	printToStdoutf(w, "  Books: %d\n", author.BooksCount)
+	printToStdoutf(w, "  Readers: %d\n", author.UsersCount)
+	printToStdoutf(w, "  Author ID: %d\n", author.ID)
+	if author.URL != "" {
+		printToStdoutf(w, "  URL: %s\n", author.URL)
+	}
+
+	if author.Bio != "" {
+		printToStdoutLn(w)
+		printToStdoutf(w, "Bio:\n%s\n", author.Bio)
+	}
+}
+
+// formatLifespan describes the known birth and death years.
+func formatLifespan(born, died int) string {
+	switch {
+	case born > 0 && died > 0:
+		return fmt.Sprintf("Born: %d, Died: %d", born, died)
+	case born > 0:
+		return fmt.Sprintf("Born: %d", born)
+	case died > 0:
+		return fmt.Sprintf("Died: %d", died)
+	default:
+		return ""
+	}
+}
+
+// authorBookView is a book in the author books command's output.
+type authorBookView struct {
+	ID           int     `json:"id"`
+	Title        string  `json:"title"`
+	Role         string  `json:"role"`
+	ReleaseYear  int     `json:"release_year"`
+	Rating       float64 `json:"rating"`
+	RatingsCount int     `json:"ratings_count"`
+	UsersCount   int     `json:"users_count"`
+	URL          string  `json:"url"`
+}
+
+// newAuthorBookView converts a client contribution into its output
+// representation.
+func newAuthorBookView(contribution *client.Contribution) authorBookView {
+	book := contribution.Book
+	view := authorBookView{
+		ID:           book.ID,
+		Title:        book.Title,
+		Role:         contribution.Role(),
+		ReleaseYear:  book.ReleaseYear,
+		Rating:       book.Rating,
+		RatingsCount: book.RatingsCount,
+		UsersCount:   book.UsersCount,
+	}
+	if book.Slug != "" {
+		view.URL = "https://hardcover.app/books/" + book.Slug
+	}
+	return view
+}
+
+// printAuthorBookViews writes the author's books as human-readable text,
+// headed by the author's name.
+func printAuthorBookViews(w io.Writer, contributions []client.Contribution, books []authorBookView) {
+	if len(books) == 0 {
+		printToStdoutf(w, "No books found.\n")
+		return
+	}
+
+	if author := contributions[0].Author; author != nil {
+		printToStdoutf(w, "Books by %s (%d)\n\n", author.Name, len(books))
+	}
+
+	for i := range books {
+		book := &books[i]
+		title := book.Title
+		if book.ReleaseYear > 0 {
+			title += fmt.Sprintf(" (%d)", book.ReleaseYear)
+		}
+		printToStdoutf(w, "%d. %s\n", i+1, title)
+		printToStdoutf(w, "   Role: %s\n", book.Role)
+		if book.RatingsCount > 0 {
+			printToStdoutf(w, "   Rating: %.2f/5 (%d ratings)\n", book.Rating, book.RatingsCount)
+		}
+		printToStdoutf(w, "   Readers: %d\n", book.UsersCount)
+		printToStdoutf(w, "   Book ID: %d\n", book.ID)
+		if book.URL != "" {
+			printToStdoutf(w, "   URL: %s\n", book.URL)
+		}
+		printSearchSeparator(w)
+	}
+}
+
+// addAuthorBooksFlags registers the filter and sort flags of the author books
+// command.
+func addAuthorBooksFlags(cmd *cobra.Command) {
+	cmd.Flags().String("role", "", "only books with this contribution role, e.g. \"Author\" or \"Translator\"")
+	cmd.Flags().String("sort", defaultAuthorBooksSort,
+		"sort by release_year, rating, title or users_count, with an optional :asc or :desc")
+}
+
+// setupAuthorCommands registers the author commands with the root command.
+func setupAuthorCommands() {
+	authorCmd.AddCommand(authorShowCmd)
+	addAuthorBooksFlags(authorBooksCmd)
+	authorCmd.AddCommand(authorBooksCmd)
+	rootCmd.AddCommand(authorCmd)
+}
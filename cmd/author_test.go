@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/testutil"
+)
+
+// testAuthor is the author returned by the author show tests.
+var testAuthor = map[string]interface{}{
+	"id":              80626,
+	"name":            "J.R.R. Tolkien",
+	"slug":            "j-r-r-tolkien",
+	"bio":             "  English writer and philologist.  ",
+	"born_year":       1892,
+	"death_year":      1973,
+	"alternate_names": []string{"John Ronald Reuel Tolkien"},
+	"location":        "Bloemfontein",
+	"books_count":     512,
+	"users_count":     40000,
+}
+
+// testAuthorContributions is the contributions response used by the author
+// books tests.
+var testAuthorContributions = map[string]interface{}{
+	"contributions": []interface{}{
+		map[string]interface{}{
+			"contribution": nil,
+			"author":       map[string]interface{}{"id": 80626, "name": "J.R.R. Tolkien", "slug": "j-r-r-tolkien"},
+			"book": map[string]interface{}{
+				"id": 1, "title": "The Hobbit", "slug": "the-hobbit", "release_year": 1937,
+				"rating": 4.31, "ratings_count": 5000, "users_count": 20000,
+			},
+		},
+		map[string]interface{}{
+			"contribution": "Translator",
+			"author":       map[string]interface{}{"id": 80626, "name": "J.R.R. Tolkien", "slug": "j-r-r-tolkien"},
+			"book": map[string]interface{}{
+				"id": 2, "title": "Sir Gawain and the Green Knight", "slug": "sir-gawain", "release_year": 1975,
+				"users_count": 800,
+			},
+		},
+	},
+}
+
+func TestAuthorShowCmd_Success(t *testing.T) {
+	var request client.GraphQLRequest
+	url := recordingServer(t, &request, map[string]interface{}{"authors": []interface{}{testAuthor}})
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, authorShowCmd.RunE(cmd, []string{"j-r-r-tolkien"}))
+
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "J.R.R. Tolkien\n\n")
+	assert.Contains(t, outputStr, "Also known as: John Ronald Reuel Tolkien")
+	assert.Contains(t, outputStr, "Born: 1892, Died: 1973")
+	assert.Contains(t, outputStr, "Location: Bloemfontein")
+	assert.Contains(t, outputStr, "Books: 512")
+	assert.Contains(t, outputStr, "Readers: 40000")
+	assert.Contains(t, outputStr, "URL: https://hardcover.app/authors/j-r-r-tolkien")
+	assert.Contains(t, outputStr, "Bio:\nEnglish writer and philologist.\n")
+
+	assert.Contains(t, request.Query, "query GetAuthor(")
+	assert.Equal(t, map[string]interface{}{"slug": map[string]interface{}{"_eq": "j-r-r-tolkien"}}, request.Variables["where"])
+}
+
+func TestAuthorShowCmd_JSONOutput(t *testing.T) {
+	var request client.GraphQLRequest
+	url := recordingServer(t, &request, map[string]interface{}{"authors": []interface{}{testAuthor}})
+	cmd, output := newBookTestCommand(url, "json")
+
+	require.NoError(t, authorShowCmd.RunE(cmd, []string{"80626"}))
+
+	var view authorView
+	require.NoError(t, json.Unmarshal(output.Bytes(), &view))
+	assert.Equal(t, 80626, view.ID)
+	assert.Equal(t, 1892, view.BornYear)
+	assert.Equal(t, []string{"John Ronald Reuel Tolkien"}, view.AlternateNames)
+	assert.Equal(t, map[string]interface{}{"id": map[string]interface{}{"_eq": float64(80626)}}, request.Variables["where"])
+}
+
+func TestAuthorShowCmd_NotFound(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(map[string]interface{}{"authors": []interface{}{}}))
+	defer server.Close()
+
+	cmd, _ := newBookTestCommand(server.URL, "text")
+	err := authorShowCmd.RunE(cmd, []string{"nobody"})
+	require.Error(t, err)
+	assert.Equal(t, `author "nobody" not found`, err.Error())
+}
+
+func TestAuthorBooksCmd_Success(t *testing.T) {
+	var request client.GraphQLRequest
+	url := recordingServer(t, &request, testAuthorContributions)
+	cmd, output := newFlagTestCommand(t, url, "text", addAuthorBooksFlags)
+
+	require.NoError(t, authorBooksCmd.RunE(cmd, []string{"80626"}))
+
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "Books by J.R.R. Tolkien (2)")
+	assert.Contains(t, outputStr, "1. The Hobbit (1937)\n   Role: Author\n   Rating: 4.31/5 (5000 ratings)\n")
+	assert.Contains(t, outputStr, "2. Sir Gawain and the Green Knight (1975)\n   Role: Translator\n   Readers: 800\n")
+
+	assert.Contains(t, request.Query, "query GetContributions(")
+	where, err := json.Marshal(request.Variables["where"])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"_and": [
+		{"contributable_type": {"_eq": "Book"}},
+		{"author_id": {"_eq": 80626}}
+	]}`, string(where))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"book": map[string]interface{}{"release_year": "asc_nulls_last"}},
+	}, request.Variables["order_by"])
+}
+
+func TestAuthorBooksCmd_RoleAndSort(t *testing.T) {
+	tests := []struct {
+		role     string
+		wantRole string
+	}{
+		{role: "author", wantRole: `{"contribution": {"_is_null": true}}`},
+		{role: "illustrator", wantRole: `{"contribution": {"_ilike": "illustrator"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			var request client.GraphQLRequest
+			url := recordingServer(t, &request, testAuthorContributions)
+			cmd, _ := newFlagTestCommand(t, url, "text", addAuthorBooksFlags,
+				"--role", tt.role, "--sort", "rating:desc")
+
+			require.NoError(t, authorBooksCmd.RunE(cmd, []string{"j-r-r-tolkien"}))
+
+			where, err := json.Marshal(request.Variables["where"])
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"_and": [
+				{"contributable_type": {"_eq": "Book"}},
+				{"author": {"slug": {"_eq": "j-r-r-tolkien"}}},
+				`+tt.wantRole+`
+			]}`, string(where))
+			assert.Equal(t, []interface{}{
+				map[string]interface{}{"book": map[string]interface{}{"rating": "desc_nulls_last"}},
+			}, request.Variables["order_by"])
+		})
+	}
+}
+
+func TestAuthorBooksCmd_InvalidSort(t *testing.T) {
+	cmd, _ := newFlagTestCommand(t, "http://unused.invalid", "text", addAuthorBooksFlags, "--sort", "pages")
+	err := authorBooksCmd.RunE(cmd, []string{"80626"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `cannot sort books by "pages" (available: release_year, rating, title, users_count)`)
+}
+
+func TestAuthorBooksCmd_CSVOutput(t *testing.T) {
+	var request client.GraphQLRequest
+	url := recordingServer(t, &request, testAuthorContributions)
+	cmd, output := newFlagTestCommand(t, url, "csv", addAuthorBooksFlags)
+
+	require.NoError(t, authorBooksCmd.RunE(cmd, []string{"80626"}))
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, "id,title,role,release_year,rating,ratings_count,users_count,url", lines[0])
+	assert.Contains(t, lines[2], "Translator")
+}
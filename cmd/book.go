@@ -0,0 +1,287 @@
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/isbn"
+)
+
+// ratingBarWidth is the width of the longest bar in the rating distribution.
+const ratingBarWidth = 30
+
+// bookCmd represents the book command.
+var bookCmd = &cobra.Command{
+	Use:   "book",
+	Short: "Look up books",
+	Long: `Commands for looking up books in the Hardcover database.
+
+Available subcommands:
+  show        Show the full detail of a book
+  editions    List the editions of a book`,
+}
+
+// bookShowCmd represents the book show command.
+var bookShowCmd = &cobra.Command{
+	Use:   "show <id|slug|isbn>",
+	Short: "Show the full detail of a book",
+	Long: `Show the full detail of a book, identified by its Hardcover ID, its URL
+slug or the ISBN-10/ISBN-13 of any of its editions.
+
+The output includes:
+- Title, subtitle and description
+- Contributors and their roles
+- Series and position in the series
+- Genres, moods and tags
+- Page count and release date
+- Average rating and rating distribution
+- Number of editions and readers
+
+Example:
+  hardcover book show 328491
+  hardcover book show dune
+  hardcover book show 978-0441013593`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		book, err := resolveBook(context.Background(), gqlClient, args[0])
+		if errors.Is(err, client.ErrNotFound) {
+			return fmt.Errorf("book %q %w", args[0], client.ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get book: %w", err)
+		}
+
+		view := newBookView(book)
+		return render(cmd, view, func(w io.Writer) {
+			printBookView(w, view)
+		})
+	},
+}
+
+// resolveBook looks a book up by ISBN, ID or slug depending on the shape of
+// the identifier.
+func resolveBook(ctx context.Context, c *client.Client, identifier string) (*client.BookDetail, error) {
+	if isbn.LooksLike(identifier) {
+		number, err := isbn.Parse(identifier)
+		if err != nil {
+			return nil, err
+		}
+		return c.GetBookByISBN(ctx, number)
+	}
+	if id, err := strconv.Atoi(identifier); err == nil {
+		return c.GetBook(ctx, id)
+	}
+	return c.GetBookBySlug(ctx, identifier)
+}
+
+// bookContributor is a contributor in the book show output.
+type bookContributor struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+// bookSeriesPosition is a series membership in the book show output.
+type bookSeriesPosition struct {
+	ID       int      `json:"id"`
+	Name     string   `json:"name"`
+	Slug     string   `json:"slug"`
+	Position *float64 `json:"position"`
+	Details  string   `json:"details"`
+}
+
+// bookView is the structured form of the book show command's output.
+type bookView struct {
+	ID                 int                   `json:"id"`
+	Title              string                `json:"title"`
+	Subtitle           string                `json:"subtitle"`
+	Slug               string                `json:"slug"`
+	URL                string                `json:"url"`
+	Contributors       []bookContributor     `json:"contributors"`
+	Series             []bookSeriesPosition  `json:"series"`
+	Genres             []string              `json:"genres"`
+	Moods              []string              `json:"moods"`
+	Tags               []string              `json:"tags"`
+	Pages              int                   `json:"pages"`
+	ReleaseDate        string                `json:"release_date"`
+	Rating             float64               `json:"rating"`
+	RatingsCount       int                   `json:"ratings_count"`
+	RatingDistribution []client.RatingBucket `json:"rating_distribution"`
+	EditionsCount      int                   `json:"editions_count"`
+	UsersCount         int                   `json:"users_count"`
+	Description        string                `json:"description"`
+}
+
+// newBookView converts a client book into its output representation. The
+// featured series is listed first.
+func newBookView(book *client.BookDetail) *bookView {
+	view := &bookView{
+		ID:                 book.ID,
+		Title:              book.Title,
+		Subtitle:           book.Subtitle,
+		Slug:               book.Slug,
+		Genres:             book.TagNames("Genre"),
+		Moods:              book.TagNames("Mood"),
+		Tags:               book.TagNames("Tag"),
+		Pages:              book.Pages,
+		ReleaseDate:        book.ReleaseDate,
+		Rating:             book.Rating,
+		RatingsCount:       book.RatingsCount,
+		RatingDistribution: book.RatingsDistribution,
+		EditionsCount:      book.EditionsCount,
+		UsersCount:         book.UsersCount,
+		Description:        strings.TrimSpace(book.Description),
+	}
+	if book.Slug != "" {
+		view.URL = "https://hardcover.app/books/" + book.Slug
+	}
+	if view.ReleaseDate == "" && book.ReleaseYear > 0 {
+		view.ReleaseDate = strconv.Itoa(book.ReleaseYear)
+	}
+
+	for i := range book.Contributions {
+		contribution := &book.Contributions[i]
+		if contribution.Author == nil {
+			continue
+		}
+		view.Contributors = append(view.Contributors, bookContributor{
+			ID:   contribution.Author.ID,
+			Name: contribution.Author.Name,
+			Role: contribution.Role(),
+		})
+	}
+
+	for _, entry := range book.BookSeries {
+		if entry.Series == nil {
+			continue
+		}
+		position := bookSeriesPosition{
+			ID:       entry.Series.ID,
+			Name:     entry.Series.Name,
+			Slug:     entry.Series.Slug,
+			Position: entry.Position,
+			Details:  entry.Details,
+		}
+		if entry.Featured {
+			view.Series = append([]bookSeriesPosition{position}, view.Series...)
+		} else {
+			view.Series = append(view.Series, position)
+		}
+	}
+
+	return view
+}
+
+// printBookView writes the human-readable book detail.
+func printBookView(w io.Writer, book *bookView) {
+	printToStdoutf(w, "%s\n", book.Title)
+	if book.Subtitle != "" {
+		printToStdoutf(w, "%s\n", book.Subtitle)
+	}
+	printToStdoutLn(w)
+
+	if len(book.Contributors) > 0 {
+		names := make([]string, 0, len(book.Contributors))
+		for _, contributor := range book.Contributors {
+			name := contributor.Name
+			if contributor.Role != client.PrimaryAuthorRole {
+				name += " (" + contributor.Role + ")"
+			}
+			names = append(names, name)
+		}
+		printToStdoutf(w, "  By: %s\n", strings.Join(names, ", "))
+	}
+	for _, series := range book.Series {
+		printToStdoutf(w, "  Series: %s\n", formatSeriesPosition(series))
+	}
+	printBookList(w, "Genres", book.Genres)
+	printBookList(w, "Moods", book.Moods)
+	printBookList(w, "Tags", book.Tags)
+	if book.Pages > 0 {
+		printToStdoutf(w, "  Pages: %d\n", book.Pages)
+	}
+	if book.ReleaseDate != "" {
+		printToStdoutf(w, "  Released: %s\n", book.ReleaseDate)
+	}
+	printToStdoutf(w, "  Editions: %d\n", book.EditionsCount)
+	printToStdoutf(w, "  Readers: %d\n", book.UsersCount)
+	printToStdoutf(w, "  Book ID: %d\n", book.ID)
+	if book.URL != "" {
+		printToStdoutf(w, "  URL: %s\n", book.URL)
+	}
+
+	if book.RatingsCount > 0 {
+		printToStdoutLn(w)
+		printToStdoutf(w, "Rating: %.2f/5 (%d ratings)\n", book.Rating, book.RatingsCount)
+		printRatingDistribution(w, book.RatingDistribution)
+	}
+
+	if book.Description != "" {
+		printToStdoutLn(w)
+		printToStdoutf(w, "Description:\n%s\n", book.Description)
+	}
+}
+
+// printBookList writes a labelled, comma-separated list if it has entries.
+func printBookList(w io.Writer, label string, items []string) {
+	if len(items) > 0 {
+		printToStdoutf(w, "  %s: %s\n", label, strings.Join(items, ", "))
+	}
+}
+
+// formatSeriesPosition formats a series membership as "Name #1.5", falling
+// back to the free-form details for unnumbered entries.
+func formatSeriesPosition(series bookSeriesPosition) string {
+	switch {
+	case series.Position != nil:
+		return series.Name + " #" + strconv.FormatFloat(*series.Position, 'f', -1, 64)
+	case series.Details != "":
+		return series.Name + " #" + series.Details
+	default:
+		return series.Name
+	}
+}
+
+// printRatingDistribution draws one bar per rating, highest rating first,
+// scaled to the most common rating.
+func printRatingDistribution(w io.Writer, buckets []client.RatingBucket) {
+	most := 0
+	for _, bucket := range buckets {
+		most = max(most, bucket.Count)
+	}
+	if most == 0 {
+		return
+	}
+
+	sorted := slices.Clone(buckets)
+	slices.SortFunc(sorted, func(a, b client.RatingBucket) int {
+		return cmp.Compare(b.Rating, a.Rating)
+	})
+	for _, bucket := range sorted {
+		filled := bucket.Count * ratingBarWidth / most
+		bar := strings.Repeat("█", filled) + strings.Repeat(" ", ratingBarWidth-filled)
+		printToStdoutf(w, "  %3.1f %s %d\n", bucket.Rating, bar, bucket.Count)
+	}
+}
+
+// setupBookCommands registers the book commands with the root command.
+func setupBookCommands() {
+	bookCmd.AddCommand(bookShowCmd)
+	addBookEditionsFlags(bookEditionsCmd)
+	bookCmd.AddCommand(bookEditionsCmd)
+	rootCmd.AddCommand(bookCmd)
+}
@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// defaultEditionSort lists the most read editions first.
+const defaultEditionSort = "users_count:desc"
+
+// bookEditionsCmd represents the book editions command.
+var bookEditionsCmd = &cobra.Command{
+	Use:   "editions <book-id|slug>",
+	Short: "List the editions of a book",
+	Long: `List every edition of a book with its format, publisher, language, page
+count or audio length, release date and ISBNs, so you can pick the right
+edition before adding it to your library.
+
+Filters (case-insensitive):
+  --format      Edition, physical or reading format, e.g. "hardcover",
+                "paperback", "audiobook" or "ebook" (substring match)
+  --language    Language name or ISO code, e.g. "English", "en" or "spa"
+  --publisher   Publisher name (substring match)
+
+Sorting:
+  --sort        release_date, pages or users_count, with an optional :asc or
+                :desc (default users_count:desc, the most read first)
+
+Example:
+  hardcover book editions 328491
+  hardcover book editions dune --format audiobook --language en
+  hardcover book editions dune --publisher ace --sort release_date:desc
+  hardcover book editions 328491 --sort pages -o table`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter, err := editionFilter(cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		editions, err := gqlClient.GetEditions(context.Background(), filter)
+		if err != nil {
+			return fmt.Errorf("failed to get editions: %w", err)
+		}
+
+		views := make([]editionView, len(editions))
+		for i := range editions {
+			views[i] = newEditionView(&editions[i])
+		}
+		return render(cmd, views, func(w io.Writer) {
+			printEditionViews(w, editions, views)
+		})
+	},
+}
+
+// editionFilter builds the client filter from the book identifier and the
+// command's flags.
+func editionFilter(cmd *cobra.Command, book string) (*client.EditionFilter, error) {
+	filter := &client.EditionFilter{}
+	if id, err := strconv.Atoi(book); err == nil {
+		filter.BookID = id
+	} else {
+		filter.BookSlug = book
+	}
+
+	if err := readStringFlags(cmd, map[string]*string{
+		"format":    &filter.Format,
+		"language":  &filter.Language,
+		"publisher": &filter.Publisher,
+	}); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if filter.OrderBy, filter.Descending, err = sortFlag(cmd, defaultEditionSort); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// editionView is the structured form of an edition in the book editions
+// command's output.
+type editionView struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	Format      string `json:"format"`
+	Publisher   string `json:"publisher"`
+	Language    string `json:"language"`
+	Pages       int    `json:"pages"`
+	AudioLength string `json:"audio_length"`
+	ReleaseDate string `json:"release_date"`
+	ISBN10      string `json:"isbn_10"`
+	ISBN13      string `json:"isbn_13"`
+	ASIN        string `json:"asin"`
+	Readers     int    `json:"readers"`
+}
+
+// newEditionView converts a client edition into its output representation.
+func newEditionView(edition *client.EditionDetail) editionView {
+	view := editionView{
+		ID:          edition.ID,
+		Title:       edition.Title,
+		Format:      edition.Format(),
+		Pages:       edition.Pages,
+		AudioLength: formatAudioLength(edition.AudioSeconds),
+		ReleaseDate: edition.ReleaseDate,
+		ISBN10:      edition.ISBN10,
+		ISBN13:      edition.ISBN13,
+		ASIN:        edition.ASIN,
+		Readers:     edition.UsersCount,
+	}
+	if edition.Publisher != nil {
+		view.Publisher = edition.Publisher.Name
+	}
+	if edition.Language != nil {
+		view.Language = edition.Language.Language
+	}
+	return view
+}
+
+// formatAudioLength formats an audiobook length as "11h 5m", or "" when the
+// length is unknown.
+func formatAudioLength(seconds int) string {
+	if seconds <= 0 {
+		return ""
+	}
+	length := time.Duration(seconds) * time.Second
+	hours := int(length.Hours())
+	minutes := int(length.Minutes()) % int(time.Hour/time.Minute)
+	if hours == 0 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %dm", hours, minutes)
+}
+
+// printEditionViews writes the editions as human-readable text, headed by
+// the title of the book they belong to.
+func printEditionViews(w io.Writer, editions []client.EditionDetail, views []editionView) {
+	if len(views) == 0 {
+		printToStdoutf(w, "No editions found.\n")
+		return
+	}
+
+	if book := editions[0].Book; book != nil {
+		printToStdoutf(w, "Editions of %s (%d)\n\n", book.Title, len(views))
+	}
+
+	for i := range views {
+		edition := &views[i]
+		printToStdoutf(w, "%d. %s\n", i+1, edition.Title)
+		printOptionalField(w, "Format", edition.Format)
+		printOptionalField(w, "Publisher", edition.Publisher)
+		printOptionalField(w, "Language", edition.Language)
+		if edition.Pages > 0 {
+			printToStdoutf(w, "   Pages: %d\n", edition.Pages)
+		}
+		printOptionalField(w, "Length", edition.AudioLength)
+		printOptionalField(w, "Released", edition.ReleaseDate)
+		printOptionalField(w, "ISBN-13", edition.ISBN13)
+		printOptionalField(w, "ISBN-10", edition.ISBN10)
+		printOptionalField(w, "ASIN", edition.ASIN)
+		printToStdoutf(w, "   Readers: %d\n", edition.Readers)
+		printToStdoutf(w, "   Edition ID: %d\n", edition.ID)
+		printSearchSeparator(w)
+	}
+}
+
+// printOptionalField writes an indented, labelled field if it has a value.
+func printOptionalField(w io.Writer, label, value string) {
+	if value != "" {
+		printToStdoutf(w, "   %s: %s\n", label, value)
+	}
+}
+
+// addBookEditionsFlags registers the filter and sort flags of the book
+// editions command.
+func addBookEditionsFlags(cmd *cobra.Command) {
+	cmd.Flags().String("format", "", "only editions with this format, e.g. \"hardcover\" or \"audiobook\"")
+	cmd.Flags().String("language", "", "only editions in this language, e.g. \"English\" or \"en\"")
+	cmd.Flags().String("publisher", "", "only editions from publishers matching this name")
+	cmd.Flags().String("sort", defaultEditionSort,
+		"sort by release_date, pages or users_count, with an optional :asc or :desc")
+}
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/testutil"
+)
+
+// testBookEditions is the editions response used by the book editions tests.
+var testBookEditions = map[string]interface{}{
+	"editions": []interface{}{
+		map[string]interface{}{
+			"id":             31,
+			"title":          "Dune",
+			"isbn_13":        "9780441013593",
+			"edition_format": "Mass Market Paperback",
+			"pages":          896,
+			"release_date":   "2005-08-02",
+			"users_count":    900,
+			"publisher":      map[string]interface{}{"id": 4, "name": "Ace"},
+			"language":       map[string]interface{}{"language": "English", "code2": "en"},
+			"book":           map[string]interface{}{"id": 328491, "title": "Dune", "slug": "dune"},
+		},
+		map[string]interface{}{
+			"id":             32,
+			"title":          "Dune",
+			"asin":           "B002V1OF70",
+			"audio_seconds":  76920,
+			"reading_format": map[string]interface{}{"format": "Listened"},
+			"users_count":    300,
+			"book":           map[string]interface{}{"id": 328491, "title": "Dune", "slug": "dune"},
+		},
+	},
+}
+
+func TestBookEditionsCmd_Success(t *testing.T) {
+	var request client.GraphQLRequest
+	url := recordingServer(t, &request, testBookEditions)
+	cmd, output := newFlagTestCommand(t, url, "text", addBookEditionsFlags)
+
+	require.NoError(t, bookEditionsCmd.RunE(cmd, []string{"328491"}))
+
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "Editions of Dune (2)")
+	assert.Contains(t, outputStr, "1. Dune\n   Format: Mass Market Paperback\n   Publisher: Ace\n   Language: English\n")
+	assert.Contains(t, outputStr, "Pages: 896")
+	assert.Contains(t, outputStr, "Released: 2005-08-02")
+	assert.Contains(t, outputStr, "ISBN-13: 9780441013593")
+	assert.Contains(t, outputStr, "2. Dune\n   Format: Listened\n   Length: 21h 22m\n")
+	assert.Contains(t, outputStr, "ASIN: B002V1OF70")
+
+	assert.Contains(t, request.Query, "query GetEditions(")
+	assert.Equal(t, map[string]interface{}{"_and": []interface{}{
+		map[string]interface{}{"book_id": map[string]interface{}{"_eq": float64(328491)}},
+	}}, request.Variables["where"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"users_count": "desc_nulls_last"}}, request.Variables["order_by"])
+}
+
+func TestBookEditionsCmd_FiltersAndSort(t *testing.T) {
+	var request client.GraphQLRequest
+	url := recordingServer(t, &request, testBookEditions)
+	cmd, _ := newFlagTestCommand(t, url, "text", addBookEditionsFlags,
+		"--format", "hardcover", "--language", "EN", "--publisher", "ace", "--sort", "release_date")
+
+	require.NoError(t, bookEditionsCmd.RunE(cmd, []string{"dune"}))
+
+	where, err := json.Marshal(request.Variables["where"])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"_and": [
+		{"book": {"slug": {"_eq": "dune"}}},
+		{"_or": [
+			{"edition_format": {"_ilike": "%hardcover%"}},
+			{"physical_format": {"_ilike": "%hardcover%"}},
+			{"reading_format": {"format": {"_ilike": "%hardcover%"}}}
+		]},
+		{"language": {"_or": [
+			{"language": {"_ilike": "EN"}},
+			{"code2": {"_eq": "en"}},
+			{"code3": {"_eq": "en"}}
+		]}},
+		{"publisher": {"name": {"_ilike": "%ace%"}}}
+	]}`, string(where))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"release_date": "asc_nulls_last"},
+		map[string]interface{}{"users_count": "desc"},
+	}, request.Variables["order_by"])
+}
+
+func TestBookEditionsCmd_TableOutput(t *testing.T) {
+	var request client.GraphQLRequest
+	url := recordingServer(t, &request, testBookEditions)
+	cmd, output := newFlagTestCommand(t, url, "table", addBookEditionsFlags)
+
+	require.NoError(t, bookEditionsCmd.RunE(cmd, []string{"328491"}))
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "ID"))
+	assert.Contains(t, lines[0], "AUDIO_LENGTH")
+	assert.Contains(t, lines[2], "21h 22m")
+}
+
+func TestBookEditionsCmd_InvalidSort(t *testing.T) {
+	tests := []struct {
+		sort    string
+		wantErr string
+	}{
+		{sort: "pages:down", wantErr: `invalid sort direction "down"`},
+		{sort: "title", wantErr: `cannot sort editions by "title" (available: release_date, pages, users_count)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			cmd, _ := newFlagTestCommand(t, "http://unused.invalid", "text", addBookEditionsFlags, "--sort", tt.sort)
+			err := bookEditionsCmd.RunE(cmd, []string{"328491"})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestBookEditionsCmd_NoEditions(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(map[string]interface{}{"editions": []interface{}{}}))
+	defer server.Close()
+
+	cmd, output := newFlagTestCommand(t, server.URL, "text", addBookEditionsFlags, "--format", "vinyl")
+	require.NoError(t, bookEditionsCmd.RunE(cmd, []string{"328491"}))
+	assert.Equal(t, "No editions found.\n", output.String())
+}
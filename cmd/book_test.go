@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/isbn"
+	"hardcover-cli/internal/testutil"
+)
+
+// testBookDetail is a book as returned by the BookDetail fragment.
+var testBookDetail = map[string]interface{}{
+	"id":            328491,
+	"title":         "Dune",
+	"subtitle":      "Deluxe Edition",
+	"slug":          "dune",
+	"description":   "Set on the desert planet Arrakis...",
+	"pages":         617,
+	"release_date":  "1965-08-01",
+	"rating":        4.27,
+	"ratings_count": 12,
+	"ratings_distribution": []interface{}{
+		map[string]interface{}{"rating": 4.5, "count": 2},
+		map[string]interface{}{"rating": 5, "count": 10},
+	},
+	"editions_count": 120,
+	"users_count":    5000,
+	"cached_tags": map[string]interface{}{
+		"Genre": []interface{}{
+			map[string]interface{}{"tag": "Science Fiction", "count": 900},
+			map[string]interface{}{"tag": "Classics", "count": 400},
+		},
+		"Mood": []interface{}{map[string]interface{}{"tag": "adventurous", "count": 300}},
+	},
+	"contributions": []interface{}{
+		map[string]interface{}{"contribution": nil, "author": map[string]interface{}{"id": 1, "name": "Frank Herbert"}},
+		map[string]interface{}{"contribution": "Illustrator", "author": map[string]interface{}{"id": 2, "name": "John Schoenherr"}},
+	},
+	"book_series": []interface{}{
+		map[string]interface{}{
+			"position": 1, "featured": true,
+			"series": map[string]interface{}{"id": 7, "name": "Dune", "slug": "dune"},
+		},
+		map[string]interface{}{
+			"position": 0.5, "featured": false,
+			"series": map[string]interface{}{"id": 8, "name": "Dune Universe", "slug": "dune-universe"},
+		},
+	},
+}
+
+// testBookResponse answers both the GetBook and the GetBookByISBN queries
+// with testBookDetail.
+var testBookResponse = map[string]interface{}{
+	"books":    []interface{}{testBookDetail},
+	"editions": []interface{}{map[string]interface{}{"book": testBookDetail}},
+}
+
+func TestBookShowCmd_Success(t *testing.T) {
+	var request client.GraphQLRequest
+	cmd, output := newBookTestCommand(recordingServer(t, &request, testBookResponse), "text")
+
+	err := bookShowCmd.RunE(cmd, []string{"dune"})
+	require.NoError(t, err)
+
+	assert.Contains(t, request.Query, "query GetBook(")
+	assert.Equal(t, map[string]interface{}{"slug": map[string]interface{}{"_eq": "dune"}}, request.Variables["where"])
+
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "Dune\nDeluxe Edition\n")
+	assert.Contains(t, outputStr, "By: Frank Herbert, John Schoenherr (Illustrator)")
+	assert.Contains(t, outputStr, "Series: Dune #1\n")
+	assert.Contains(t, outputStr, "Series: Dune Universe #0.5\n")
+	assert.Contains(t, outputStr, "Genres: Science Fiction, Classics")
+	assert.Contains(t, outputStr, "Moods: adventurous")
+	assert.Contains(t, outputStr, "Pages: 617")
+	assert.Contains(t, outputStr, "Released: 1965-08-01")
+	assert.Contains(t, outputStr, "Editions: 120")
+	assert.Contains(t, outputStr, "Rating: 4.27/5 (12 ratings)")
+	assert.Contains(t, outputStr, "URL: https://hardcover.app/books/dune")
+	assert.Contains(t, outputStr, "Set on the desert planet Arrakis...")
+	assert.Less(t, strings.Index(outputStr, "5.0 "), strings.Index(outputStr, "4.5 "))
+}
+
+func TestBookShowCmd_ResolvesIdentifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		identifier string
+		query      string
+		variable   string
+		want       interface{}
+	}{
+		{
+			name:       "id",
+			identifier: "328491",
+			query:      "query GetBook(",
+			variable:   "where",
+			want:       map[string]interface{}{"id": map[string]interface{}{"_eq": float64(328491)}},
+		},
+		{
+			name:       "isbn-13 with hyphens",
+			identifier: "978-0-441-01359-3",
+			query:      "query GetBookByISBN(",
+			variable:   "isbn_10",
+			want:       []interface{}{"0441013597"},
+		},
+		{
+			name:       "isbn-10 with check digit X",
+			identifier: "080442957x",
+			query:      "query GetBookByISBN(",
+			variable:   "isbn_13",
+			want:       []interface{}{"9780804429573"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var request client.GraphQLRequest
+			cmd, _ := newBookTestCommand(recordingServer(t, &request, testBookResponse), "text")
+
+			require.NoError(t, bookShowCmd.RunE(cmd, []string{tt.identifier}))
+			assert.Contains(t, request.Query, tt.query)
+			assert.Equal(t, tt.want, request.Variables[tt.variable])
+		})
+	}
+}
+
+func TestBookShowCmd_JSONOutput(t *testing.T) {
+	var request client.GraphQLRequest
+	cmd, output := newBookTestCommand(recordingServer(t, &request, testBookResponse), "json")
+
+	require.NoError(t, bookShowCmd.RunE(cmd, []string{"dune"}))
+
+	var book bookView
+	require.NoError(t, json.Unmarshal(output.Bytes(), &book))
+	assert.Equal(t, 328491, book.ID)
+	assert.Equal(t, []bookContributor{
+		{ID: 1, Name: "Frank Herbert", Role: "Author"},
+		{ID: 2, Name: "John Schoenherr", Role: "Illustrator"},
+	}, book.Contributors)
+	require.Len(t, book.Series, 2)
+	assert.Equal(t, "Dune", book.Series[0].Name)
+	assert.Len(t, book.RatingDistribution, 2)
+}
+
+func TestBookShowCmd_NotFound(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(map[string]interface{}{"books": []interface{}{}}))
+	defer server.Close()
+
+	cmd, _ := newBookTestCommand(server.URL, "text")
+	err := bookShowCmd.RunE(cmd, []string{"no-such-book"})
+	require.Error(t, err)
+	assert.Equal(t, `book "no-such-book" not found`, err.Error())
+}
+
+func TestBookShowCmd_InvalidISBN(t *testing.T) {
+	cmd, _ := newBookTestCommand("http://unused.invalid", "text")
+	err := bookShowCmd.RunE(cmd, []string{"9780441013590"})
+	require.ErrorIs(t, err, isbn.ErrInvalidChecksum)
+}
+
+func TestBookShowCmd_MissingAPIKey(t *testing.T) {
+	cmd, _ := newBookTestCommand("http://unused.invalid", "text")
+	cmd.SetContext(testutil.WithTestConfigAdapter(context.Background(), testutil.SetupTestConfig(&testutil.TestConfig{})))
+
+	err := bookShowCmd.RunE(cmd, []string{"dune"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "API key is required")
+}
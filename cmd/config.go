@@ -3,6 +3,7 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -53,14 +54,18 @@ Example:
 			return fmt.Errorf("failed to save configuration: %w", saveErr)
 		}
 
-		printToStdoutLn(cmd.OutOrStdout(), "API key has been set and saved to configuration file.")
-
+		view := &configAPIKeyView{Set: true, APIKey: maskAPIKey(apiKey), Source: configSourceFile}
 		// Show the configuration file path
 		if configPath, pathErr := config.GetConfigPath(); pathErr == nil {
-			printToStdoutf(cmd.OutOrStdout(), "Configuration file: %s\n", configPath)
+			view.ConfigPath = configPath
 		}
 
-		return nil
+		return render(cmd, view, func(w io.Writer) {
+			printToStdoutLn(w, "API key has been set and saved to configuration file.")
+			if view.ConfigPath != "" {
+				printToStdoutf(w, "Configuration file: %s\n", view.ConfigPath)
+			}
+		})
 	},
 }
 
@@ -84,28 +89,19 @@ Example:
 			return fmt.Errorf("failed to load configuration: %w", err)
 		}
 
-		if cfg.APIKey == "" {
-			printToStdoutLn(cmd.OutOrStdout(), "No API key is currently set.")
-			printToStdoutLn(cmd.OutOrStdout(), "")
-			printToStdoutLn(cmd.OutOrStdout(), "You can set it using:")
-			printToStdoutLn(cmd.OutOrStdout(), "  hardcover config set-api-key <your-api-key>")
-			printToStdoutLn(cmd.OutOrStdout(), "  export HARDCOVER_API_KEY=<your-api-key>")
-			return nil
+		view := &configAPIKeyView{Set: cfg.APIKey != ""}
+		if view.Set {
+			// Mask the API key for security
+			view.APIKey = maskAPIKey(cfg.APIKey)
+			view.Source = configSourceFile
+			if envKey := os.Getenv("HARDCOVER_API_KEY"); envKey != "" && envKey == cfg.APIKey {
+				view.Source = configSourceEnvironment
+			}
 		}
 
-		// Mask the API key for security
-		maskedKey := maskAPIKey(cfg.APIKey)
-		printToStdoutf(cmd.OutOrStdout(), "API key: %s\n", maskedKey)
-
-		// Determine source
-		envKey := os.Getenv("HARDCOVER_API_KEY")
-		if envKey != "" && envKey == cfg.APIKey {
-			printToStdoutLn(cmd.OutOrStdout(), "Source: Environment variable (HARDCOVER_API_KEY)")
-		} else {
-			printToStdoutLn(cmd.OutOrStdout(), "Source: Configuration file")
-		}
-
-		return nil
+		return render(cmd, view, func(w io.Writer) {
+			printAPIKey(w, view)
+		})
 	},
 }
 
@@ -125,19 +121,60 @@ Example:
 			return fmt.Errorf("failed to get configuration path: %w", err)
 		}
 
-		printToStdoutf(cmd.OutOrStdout(), "Configuration file path: %s\n", configPath)
-
 		// Check if file exists
-		if _, statErr := os.Stat(configPath); os.IsNotExist(statErr) {
-			printToStdoutLn(cmd.OutOrStdout(), "Configuration file does not exist yet.")
-		} else {
-			printToStdoutLn(cmd.OutOrStdout(), "Configuration file exists.")
-		}
-
-		return nil
+		_, statErr := os.Stat(configPath)
+		view := &configPathView{Path: configPath, Exists: !os.IsNotExist(statErr)}
+
+		return render(cmd, view, func(w io.Writer) {
+			printToStdoutf(w, "Configuration file path: %s\n", view.Path)
+			if view.Exists {
+				printToStdoutLn(w, "Configuration file exists.")
+			} else {
+				printToStdoutLn(w, "Configuration file does not exist yet.")
+			}
+		})
 	},
 }
 
+// Where the API key shown by the config commands comes from.
+const (
+	configSourceFile        = "config_file"
+	configSourceEnvironment = "environment"
+)
+
+// configAPIKeyView is the structured form of the API key commands' output.
+// The key is always masked.
+type configAPIKeyView struct {
+	Set        bool   `json:"set"`
+	APIKey     string `json:"api_key,omitempty"`
+	Source     string `json:"source,omitempty"`
+	ConfigPath string `json:"config_path,omitempty"`
+}
+
+// configPathView is the structured form of the show-path command's output.
+type configPathView struct {
+	Path   string `json:"path"`
+	Exists bool   `json:"exists"`
+}
+
+// printAPIKey writes the current API key, or how to set one, as text.
+func printAPIKey(w io.Writer, view *configAPIKeyView) {
+	if !view.Set {
+		printToStdoutLn(w, "No API key is currently set.")
+		printToStdoutLn(w, "")
+		printToStdoutLn(w, "You can set it using:")
+		printToStdoutLn(w, "  hardcover config set-api-key <your-api-key>")
+		printToStdoutLn(w, "  export HARDCOVER_API_KEY=<your-api-key>")
+		return
+	}
+	printToStdoutf(w, "API key: %s\n", view.APIKey)
+	if view.Source == configSourceEnvironment {
+		printToStdoutLn(w, "Source: Environment variable (HARDCOVER_API_KEY)")
+	} else {
+		printToStdoutLn(w, "Source: Configuration file")
+	}
+}
+
 // setupConfigCommands registers the config commands with the root command.
 func setupConfigCommands() {
 	configCmd.AddCommand(configSetAPIKeyCmd)
@@ -218,6 +218,32 @@ func TestConfigShowPathCmd_FileExists(t *testing.T) {
 	assert.NotContains(t, outputStr, "does not exist yet")
 }
 
+func TestConfigCmds_JSONOutput(t *testing.T) {
+	ctm := testutil.NewConfigTestManager(t)
+	defer ctm.Cleanup()
+	envMgr := testutil.NewEnvironmentManager(t)
+	defer envMgr.Cleanup()
+	envMgr.UnsetEnv("HARDCOVER_API_KEY")
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("output", "json", "")
+	cmd.SetContext(context.Background())
+	var output bytes.Buffer
+	cmd.SetOut(&output)
+
+	require.NoError(t, configShowPathCmd.RunE(cmd, nil))
+	assert.JSONEq(t, `{"path": "`+ctm.GetConfigPath()+`", "exists": false}`, output.String())
+
+	output.Reset()
+	require.NoError(t, configSetAPIKeyCmd.RunE(cmd, []string{"test-api-key-123"}))
+	assert.JSONEq(t, `{"set": true, "api_key": "test...-123", "source": "config_file", "config_path": "`+
+		ctm.GetConfigPath()+`"}`, output.String())
+
+	output.Reset()
+	require.NoError(t, configGetAPIKeyCmd.RunE(cmd, nil))
+	assert.JSONEq(t, `{"set": true, "api_key": "test...-123", "source": "config_file"}`, output.String())
+}
+
 func TestConfigCmd_CommandProperties(t *testing.T) {
 	// Test config command properties
 	assert.Equal(t, "config", configCmd.Use)
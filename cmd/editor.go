@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// defaultEditor is launched when neither $VISUAL nor $EDITOR is set.
+const defaultEditor = "vi"
+
+// launchEditor opens path in the user's editor and waits for it to exit.
+// Tests replace it to simulate editing.
+var launchEditor = func(path string) error {
+	fields := editorCommand()
+	//nolint:gosec // running the user's chosen editor is the point
+	editorCmd := exec.Command(fields[0], append(fields[1:], path)...)
+	editorCmd.Stdin = os.Stdin
+	editorCmd.Stdout = os.Stdout
+	editorCmd.Stderr = os.Stderr
+	if err := editorCmd.Run(); err != nil {
+		return fmt.Errorf("editor %q failed: %w", strings.Join(fields, " "), err)
+	}
+	return nil
+}
+
+// editorCommand returns the editor named by $VISUAL or $EDITOR split into
+// its program and arguments, e.g. "code --wait". A variable holding only
+// whitespace counts as unset.
+func editorCommand() []string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(name)); len(fields) > 0 {
+			return fields
+		}
+	}
+	return []string{defaultEditor}
+}
+
+// editText lets the user edit text in their editor, using a temporary file
+// whose name ends in pattern (e.g. "*.md"). It returns the edited text and
+// the file's path; the caller removes the file once the text is saved, so
+// that a draft that fails validation is not lost.
+func editText(text, pattern string) (string, string, error) {
+	file, err := os.CreateTemp("", "hardcover-"+pattern)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	path := file.Name()
+	_, writeErr := file.WriteString(text)
+	if closeErr := file.Close(); writeErr == nil {
+		writeErr = closeErr
+	}
+	if writeErr != nil {
+		return "", "", errors.Join(fmt.Errorf("failed to write temporary file: %w", writeErr), os.Remove(path))
+	}
+
+	if err := launchEditor(path); err != nil {
+		return "", path, err
+	}
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		return "", path, fmt.Errorf("failed to read edited file: %w", err)
+	}
+	return string(edited), path, nil
+}
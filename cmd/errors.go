@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"hardcover-cli/internal/client"
+)
+
+// Exit codes of the CLI, so scripts can tell failures apart. 2 is left for
+// usage errors.
+const (
+	exitCodeError        = 1
+	exitCodeUnauthorized = 3
+	exitCodeRateLimited  = 4
+	exitCodeNotFound     = 5
+	exitCodeAPI          = 6
+	exitCodeHTTP         = 7
+)
+
+// exitCode returns the exit code for an error returned by a command.
+func exitCode(err error) int {
+	var gqlErrs *client.GraphQLErrors
+	var httpErr *client.HTTPError
+	switch {
+	case errors.Is(err, client.ErrUnauthorized):
+		return exitCodeUnauthorized
+	case errors.Is(err, client.ErrRateLimited):
+		return exitCodeRateLimited
+	case errors.Is(err, client.ErrNotFound):
+		return exitCodeNotFound
+	case errors.As(err, &gqlErrs):
+		return exitCodeAPI
+	case errors.As(err, &httpErr):
+		return exitCodeHTTP
+	default:
+		return exitCodeError
+	}
+}
+
+// errorHint returns advice on what to do about an error returned by a
+// command, or "" when there is none.
+func errorHint(err error) string {
+	var gqlErrs *client.GraphQLErrors
+	var httpErr *client.HTTPError
+	isGraphQL, isHTTP := errors.As(err, &gqlErrs), errors.As(err, &httpErr)
+	switch {
+	case errors.Is(err, client.ErrUnauthorized):
+		return "Hardcover rejected your API key. Get a new one from https://hardcover.app/account/developer\n" +
+			"and save it with:\n  hardcover config set-api-key \"your-api-key\""
+	case errors.Is(err, client.ErrRateLimited):
+		wait := "a minute"
+		if isHTTP && httpErr.RetryAfter > 0 {
+			wait = httpErr.RetryAfter.Round(time.Second).String()
+		}
+		return fmt.Sprintf("Hardcover's rate limit was reached. Try again in %s, or lower --rate-limit.", wait)
+	case isGraphQL && gqlErrs.HasCode("access-denied"):
+		return "Your account is not allowed to do this. Check that the book, list or entry is yours."
+	case isGraphQL && gqlErrs.HasCode("validation-failed"):
+		return "The API rejected the request, which usually means it has changed since this\n" +
+			"version of hardcover-cli. Check for a newer version."
+	case isHTTP && httpErr.StatusCode >= http.StatusInternalServerError:
+		return "Hardcover's API is having problems. Try again later, or raise --max-retries."
+	default:
+		return ""
+	}
+}
+
+// printWarnings writes a warning to w for each error the API reported
+// alongside the data it returned.
+func printWarnings(w io.Writer, warnings *client.GraphQLErrors) {
+	if warnings == nil {
+		return
+	}
+	for _, warning := range warnings.Errors {
+		if path := warning.FieldPath(); path != "" {
+			printToStdoutf(w, "Warning: failed to load %s: %s\n", path, warning.Message)
+		} else {
+			printToStdoutf(w, "Warning: %s\n", warning.Message)
+		}
+	}
+}
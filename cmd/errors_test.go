@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"hardcover-cli/internal/client"
+)
+
+func TestExitCodeAndHint(t *testing.T) {
+	graphQLErrors := func(code string) error {
+		return fmt.Errorf("failed to get book: %w", &client.GraphQLErrors{Errors: []client.GraphQLError{
+			{Message: "failed", Extensions: client.GraphQLErrorExtensions{Code: code}},
+		}})
+	}
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantHint string
+	}{
+		{name: "other", err: errors.New("boom"), wantCode: exitCodeError},
+		{name: "not found", err: fmt.Errorf("book %q %w", "dune", client.ErrNotFound), wantCode: exitCodeNotFound},
+		{
+			name:     "expired key",
+			err:      graphQLErrors("invalid-jwt"),
+			wantCode: exitCodeUnauthorized,
+			wantHint: "hardcover config set-api-key",
+		},
+		{
+			name:     "forbidden",
+			err:      &client.HTTPError{StatusCode: http.StatusForbidden},
+			wantCode: exitCodeUnauthorized,
+			wantHint: "rejected your API key",
+		},
+		{
+			name:     "rate limited",
+			err:      &client.HTTPError{StatusCode: http.StatusTooManyRequests, RetryAfter: 30 * time.Second},
+			wantCode: exitCodeRateLimited,
+			wantHint: "Try again in 30s",
+		},
+		{name: "access denied", err: graphQLErrors("access-denied"), wantCode: exitCodeAPI, wantHint: "not allowed"},
+		{name: "invalid query", err: graphQLErrors("validation-failed"), wantCode: exitCodeAPI, wantHint: "newer version"},
+		{
+			name:     "server error",
+			err:      &client.HTTPError{StatusCode: http.StatusBadGateway},
+			wantCode: exitCodeHTTP,
+			wantHint: "--max-retries",
+		},
+		{name: "bad request", err: &client.HTTPError{StatusCode: http.StatusBadRequest}, wantCode: exitCodeHTTP},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantCode, exitCode(tt.err))
+			if tt.wantHint == "" {
+				assert.Empty(t, errorHint(tt.err))
+			} else {
+				assert.Contains(t, errorHint(tt.err), tt.wantHint)
+			}
+		})
+	}
+}
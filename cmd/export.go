@@ -0,0 +1,321 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// Formats the export command writes.
+const (
+	exportFormatGoodreads = "goodreads"
+	exportFormatArchive   = "archive"
+)
+
+// The schema and version written to, and required of, library archives.
+// The version changes whenever the archive layout does.
+const (
+	archiveSchema  = "hardcover-cli/library-archive"
+	archiveVersion = 1
+)
+
+// goodreadsColumns are the columns of a Goodreads library export, in
+// Goodreads' order.
+var goodreadsColumns = []string{
+	"Book Id", "Title", "Author", "Author l-f", "Additional Authors", "ISBN", "ISBN13",
+	"My Rating", "Average Rating", "Publisher", "Binding", "Number of Pages", "Year Published",
+	"Original Publication Year", "Date Read", "Date Added", "Bookshelves", "Bookshelves with positions",
+	"Exclusive Shelf", "My Review", "Spoiler", "Private Notes", "Read Count", "Owned Copies",
+}
+
+// exportCmd represents the export command.
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export your library to a Goodreads CSV or a JSON archive",
+	Long: `Export your whole Hardcover library: every book on your shelves with its
+status, rating, review and reads, your reading journal and your lists.
+
+Two formats are available with --format:
+  archive    A versioned JSON archive holding everything exactly as
+             Hardcover returns it. Restore it with
+             "hardcover import hardcover-archive".
+  goodreads  A CSV file in the layout of a Goodreads library export, which
+             Goodreads, StoryGraph and most other services can import. The
+             lists a book is on become its shelves; journals are left out.
+
+The export is written to standard output unless --file is given. The
+global --output flag does not apply: choose the format with --format.
+
+Example:
+  hardcover export --file library.json
+  hardcover export --format goodreads --file goodreads.csv
+  hardcover export --format goodreads > goodreads.csv`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if cmd.Flags().Changed("output") {
+			return errors.New("export does not support --output; use --format " +
+				exportFormatArchive + " or --format " + exportFormatGoodreads)
+		}
+		var format, path string
+		if err := readStringFlags(cmd, map[string]*string{
+			"format": &format,
+			"file":   &path,
+		}); err != nil {
+			return err
+		}
+		if format != exportFormatArchive && format != exportFormatGoodreads {
+			return fmt.Errorf("unknown export format %q (available: %s, %s)", format, exportFormatArchive, exportFormatGoodreads)
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+		archive, err := fetchLibraryArchive(context.Background(), gqlClient)
+		if err != nil {
+			return err
+		}
+
+		write := func(w io.Writer) error { return writeArchive(w, archive) }
+		if format == exportFormatGoodreads {
+			write = func(w io.Writer) error { return writeGoodreadsCSV(w, archive) }
+		}
+		if path == "" {
+			return write(cmd.OutOrStdout())
+		}
+		if err := writeExportFile(path, write); err != nil {
+			return err
+		}
+		printToStdoutf(cmd.OutOrStdout(), "Exported %s, %s and %s to %s.\n",
+			pluralize(len(archive.Books), "book", "books"),
+			pluralize(len(archive.Journals), "journal entry", "journal entries"),
+			pluralize(len(archive.Lists), "list", "lists"), path)
+		return nil
+	},
+}
+
+// libraryArchive is the JSON archive written by export and restored by
+// import hardcover-archive.
+type libraryArchive struct {
+	Schema     string                  `json:"schema"`
+	Version    int                     `json:"version"`
+	ExportedAt string                  `json:"exported_at"`
+	UserID     int                     `json:"user_id"`
+	Books      []client.UserBook       `json:"books"`
+	Journals   []client.ReadingJournal `json:"journals"`
+	Lists      []client.List           `json:"lists"`
+}
+
+// fetchLibraryArchive fetches the current user's shelf entries, journal and
+// lists. Unlike library list it fails on any field the API cannot load
+// rather than warning: an archive missing an entry's edition or reads would
+// be restored without them, and nothing would record what was lost.
+func fetchLibraryArchive(ctx context.Context, c *client.Client) (*libraryArchive, error) {
+	userID, err := c.CurrentUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	archive := &libraryArchive{
+		Schema:     archiveSchema,
+		Version:    archiveVersion,
+		ExportedAt: time.Now().UTC().Format(time.RFC3339),
+		UserID:     userID,
+	}
+	if archive.Books, err = c.GetUserBooks(ctx, &client.UserBookFilter{UserID: userID}); err != nil {
+		return nil, fmt.Errorf("failed to get library: %w", err)
+	}
+	if archive.Journals, err = c.GetReadingJournals(ctx, &client.ReadingJournalFilter{UserID: userID}); err != nil {
+		return nil, fmt.Errorf("failed to get journal: %w", err)
+	}
+	if archive.Lists, err = c.GetLists(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to get lists: %w", err)
+	}
+	return archive, nil
+}
+
+// writeExportFile writes an export to path, removing the file again when
+// writing fails.
+func writeExportFile(path string, write func(w io.Writer) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create export: %w", err)
+	}
+	err = write(file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+	return nil
+}
+
+// writeArchive writes the archive as indented JSON.
+func writeArchive(w io.Writer, archive *libraryArchive) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(archive)
+}
+
+// writeGoodreadsCSV writes the archive's shelf entries in the layout of a
+// Goodreads export.
+func writeGoodreadsCSV(w io.Writer, archive *libraryArchive) error {
+	shelves := make(map[int][]string)
+	for i := range archive.Lists {
+		for _, listBook := range archive.Lists[i].ListBooks {
+			shelves[listBook.BookID] = append(shelves[listBook.BookID], archive.Lists[i].Slug)
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(goodreadsColumns); err != nil {
+		return err
+	}
+	for i := range archive.Books {
+		entry := &archive.Books[i]
+		if err := writer.Write(goodreadsRow(entry, shelves[entry.BookID])); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// goodreadsRow converts a shelf entry to a row of a Goodreads export. The
+// Book Id column is left empty: Hardcover IDs are not Goodreads IDs.
+func goodreadsRow(entry *client.UserBook, lists []string) []string {
+	shelf := goodreadsShelf(entry.StatusID)
+	dateRead, readCount := lastFinished(entry.Reads)
+	values := map[string]string{
+		"My Rating":       strconv.Itoa(int(math.Round(entry.Rating))),
+		"Date Read":       goodreadsDate(dateRead),
+		"Date Added":      goodreadsDate(entry.DateAdded),
+		"Bookshelves":     strings.Join(append([]string{shelf}, lists...), ", "),
+		"Exclusive Shelf": shelf,
+		"My Review":       strings.ReplaceAll(entry.ReviewRaw, "\n", "<br/>"),
+		"Private Notes":   entry.PrivateNotes,
+		"Read Count":      strconv.Itoa(readCount),
+		"Owned Copies":    "0",
+	}
+	if entry.ReviewRaw != "" {
+		values["Spoiler"] = strconv.FormatBool(entry.ReviewHasSpoilers)
+	}
+	if entry.Owned {
+		values["Owned Copies"] = "1"
+	}
+
+	if book := entry.Book; book != nil {
+		values["Title"] = book.Title
+		values["Number of Pages"] = formatOptionalInt(book.Pages)
+		values["Year Published"] = formatOptionalInt(book.ReleaseYear)
+		values["Original Publication Year"] = values["Year Published"]
+		if book.Rating > 0 {
+			values["Average Rating"] = strconv.FormatFloat(book.Rating, 'f', 2, 64)
+		}
+		if authors := authorNames(book.Contributions); len(authors) > 0 {
+			values["Author"] = authors[0]
+			values["Author l-f"] = lastFirst(authors[0])
+			values["Additional Authors"] = strings.Join(authors[1:], ", ")
+		}
+	}
+	if edition := entry.Edition; edition != nil {
+		values["ISBN"] = goodreadsFormula(edition.ISBN10)
+		values["ISBN13"] = goodreadsFormula(edition.ISBN13)
+		values["Binding"] = edition.Format()
+		if edition.Pages > 0 {
+			values["Number of Pages"] = strconv.Itoa(edition.Pages)
+		}
+	}
+
+	row := make([]string, len(goodreadsColumns))
+	for i, column := range goodreadsColumns {
+		row[i] = values[column]
+	}
+	return row
+}
+
+// goodreadsShelf returns the Goodreads exclusive shelf for a status. Statuses
+// without a Goodreads shelf use their slug, which the Goodreads importer
+// maps back.
+func goodreadsShelf(status client.UserBookStatus) string {
+	for shelf, shelfStatus := range goodreadsShelves {
+		if shelfStatus == status {
+			return shelf
+		}
+	}
+	return status.Slug()
+}
+
+// goodreadsFormula writes an ISBN the way Goodreads does, as a spreadsheet
+// formula that keeps leading zeros.
+func goodreadsFormula(value string) string {
+	return `="` + value + `"`
+}
+
+// goodreadsDate converts a date or timestamp to Goodreads' YYYY/MM/DD.
+func goodreadsDate(value string) string {
+	if len(value) < len(time.DateOnly) {
+		return ""
+	}
+	return strings.ReplaceAll(value[:len(time.DateOnly)], "-", "/")
+}
+
+// lastFinished returns the latest finish date among reads and the number of
+// finished reads.
+func lastFinished(reads []client.UserBookRead) (string, int) {
+	var last string
+	count := 0
+	for _, read := range reads {
+		if read.FinishedAt == "" {
+			continue
+		}
+		count++
+		if read.FinishedAt > last {
+			last = read.FinishedAt
+		}
+	}
+	return last, count
+}
+
+// lastFirst converts "Frank Herbert" to "Herbert, Frank".
+func lastFirst(name string) string {
+	space := strings.LastIndex(name, " ")
+	if space < 0 {
+		return name
+	}
+	return name[space+1:] + ", " + name[:space]
+}
+
+// formatOptionalInt formats n, or returns "" when it is zero.
+func formatOptionalInt(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+// addExportFlags registers the flags of the export command.
+func addExportFlags(cmd *cobra.Command) {
+	cmd.Flags().String("format", exportFormatArchive, "export format ("+exportFormatArchive+", "+exportFormatGoodreads+")")
+	cmd.Flags().StringP("file", "f", "", "write the export to a file instead of standard output")
+}
+
+// setupExportCommands registers the export command with the root command.
+func setupExportCommands() {
+	addExportFlags(exportCmd)
+	rootCmd.AddCommand(exportCmd)
+}
@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/client"
+)
+
+// newExportLibrary starts a fake library holding a read, reviewed and owned
+// Dune, its journal and a ranked list.
+func newExportLibrary(t *testing.T) (*fakeLibrary, string) {
+	t.Helper()
+
+	library, url := newFakeLibrary(t, map[int]map[string]interface{}{
+		328491: {
+			"id": 7, "book_id": 328491, "edition_id": 31, "status_id": 3, "rating": 4.5, "owned": true,
+			"date_added": "2024-01-15", "review_raw": "Spice.\nWorms.", "review_has_spoilers": true,
+			"reviewed_at": "2024-03-02T10:00:00+00:00", "private_notes": "Reread in winter",
+			"privacy_setting_id": 2, "reading_format_id": 1, "book": testBookDetail, "edition": testEdition,
+			"user_book_reads": []interface{}{
+				map[string]interface{}{"id": 70, "edition_id": 31, "started_at": "2024-02-01", "finished_at": "2024-03-01"},
+			},
+		},
+	})
+	library.journals = duneJournal()
+	library.lists = testLists()
+	return library, url
+}
+
+func TestExportCmd_Archive(t *testing.T) {
+	_, url := newExportLibrary(t)
+	cmd, output := newFlagTestCommand(t, url, "text", addExportFlags)
+
+	require.NoError(t, exportCmd.RunE(cmd, nil))
+
+	archive, err := readArchive(strings.NewReader(output.String()))
+	require.NoError(t, err)
+	assert.Equal(t, 42, archive.UserID)
+	assert.NotEmpty(t, archive.ExportedAt)
+	require.Len(t, archive.Books, 1)
+	assert.Equal(t, "Spice.\nWorms.", archive.Books[0].ReviewRaw)
+	assert.Equal(t, client.ReadingFormatPhysical, archive.Books[0].ReadingFormatID)
+	require.Len(t, archive.Books[0].Reads, 1)
+	assert.Len(t, archive.Journals, 2)
+	require.Len(t, archive.Lists, 1)
+	assert.Len(t, archive.Lists[0].ListBooks, 3)
+}
+
+func TestExportCmd_Goodreads(t *testing.T) {
+	_, url := newExportLibrary(t)
+	cmd, output := newFlagTestCommand(t, url, "text", addExportFlags, "--format", "goodreads")
+
+	require.NoError(t, exportCmd.RunE(cmd, nil))
+
+	rows, err := csv.NewReader(strings.NewReader(output.String())).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, goodreadsColumns, rows[0])
+	row := make(map[string]string)
+	for i, column := range rows[0] {
+		row[column] = rows[1][i]
+	}
+	assert.Equal(t, "Dune", row["Title"])
+	assert.Equal(t, "Frank Herbert", row["Author"])
+	assert.Equal(t, "Herbert, Frank", row["Author l-f"])
+	assert.Equal(t, `="9780441013593"`, row["ISBN13"])
+	assert.Equal(t, "5", row["My Rating"])
+	assert.Equal(t, "2024/03/01", row["Date Read"])
+	assert.Equal(t, "2024/01/15", row["Date Added"])
+	assert.Equal(t, "read, favourite-sf", row["Bookshelves"])
+	assert.Equal(t, "read", row["Exclusive Shelf"])
+	assert.Equal(t, "Spice.<br/>Worms.", row["My Review"])
+	assert.Equal(t, "1", row["Owned Copies"])
+
+	records, err := parseGoodreadsExport(strings.NewReader(output.String()))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, client.StatusRead, records[0].Status)
+	assert.Equal(t, "Spice.\nWorms.", records[0].Review)
+}
+
+func TestExportCmd_UnknownFormat(t *testing.T) {
+	cmd, _ := newFlagTestCommand(t, "http://unused.invalid", "text", addExportFlags, "--format", "xml")
+
+	err := exportCmd.RunE(cmd, nil)
+	require.Error(t, err)
+	assert.Equal(t, `unknown export format "xml" (available: archive, goodreads)`, err.Error())
+}
+
+func TestExportCmd_RejectsOutputFlag(t *testing.T) {
+	library, url := newExportLibrary(t)
+	cmd, _ := newFlagTestCommand(t, url, "text", addExportFlags, "--output", "json")
+
+	err := exportCmd.RunE(cmd, nil)
+	require.Error(t, err)
+	assert.Equal(t, "export does not support --output; use --format archive or --format goodreads", err.Error())
+	assert.Empty(t, library.calls)
+}
+
+func TestImportArchiveCmd_RestoresExport(t *testing.T) {
+	_, exportURL := newExportLibrary(t)
+	cmd, output := newFlagTestCommand(t, exportURL, "text", addExportFlags)
+	require.NoError(t, exportCmd.RunE(cmd, nil))
+	path := writeImportFile(t, "library.json", output.String())
+
+	library, url := newFakeLibrary(t, nil)
+	cmd, output = newFlagTestCommand(t, url, "text", addImportFlags)
+
+	require.NoError(t, importArchiveCmd.RunE(cmd, []string{path}))
+
+	assert.Contains(t, output.String(), "Line 1: Dune by Frank Herbert: ")
+	assert.Contains(t, output.String(), "List \"Favourite SF\": created with 3 books\n\nImported 1 of 1 rows.\n")
+	require.Equal(t, []string{
+		"InsertUserBook", "InsertUserBookRead", "InsertReadingJournal",
+		"InsertList", "InsertListBook", "InsertListBook", "InsertListBook",
+	}, library.operations())
+	assert.Equal(t, map[string]interface{}{
+		"book_id": float64(328491), "status_id": float64(3), "edition_id": float64(31), "rating": 4.5,
+		"review_raw": "Spice.\nWorms.", "review_has_spoilers": true, "reviewed_at": "2024-03-02T10:00:00+00:00",
+		"private_notes": "Reread in winter", "privacy_setting_id": float64(2), "date_added": "2024-01-15",
+		"owned": true, "reading_format_id": float64(1),
+	}, library.mutations[0].Variables["object"])
+	assert.Equal(t, map[string]interface{}{
+		"book_id": float64(328491), "event": "note", "entry": "Paul's visions are unreliable.\nOr are they?",
+		"privacy_setting_id": float64(1), "metadata": map[string]interface{}{"page": float64(142), "chapter": "3"},
+	}, library.mutations[2].Variables["object"])
+	assert.Equal(t, map[string]interface{}{
+		"list_id": float64(504), "book_id": float64(328491), "position": float64(1), "reason": "The one that started it all",
+	}, library.mutations[4].Variables["object"])
+
+	output.Reset()
+	require.NoError(t, importArchiveCmd.RunE(cmd, []string{path}))
+	assert.Contains(t, output.String(), "List \"Favourite SF\": restored by an earlier run\n")
+	assert.Len(t, library.mutations, 7)
+}
+
+func TestImportArchiveCmd_LoadsShelvesOnce(t *testing.T) {
+	exportLibrary, exportURL := newExportLibrary(t)
+	exportLibrary.userBooks[1] = map[string]interface{}{
+		"id": 8, "book_id": 1, "status_id": 1, "book": map[string]interface{}{"id": 1, "title": "Children of Dune"},
+	}
+	cmd, output := newFlagTestCommand(t, exportURL, "text", addExportFlags)
+	require.NoError(t, exportCmd.RunE(cmd, nil))
+	path := writeImportFile(t, "library.json", output.String())
+
+	library, url := newFakeLibrary(t, nil)
+	cmd, _ = newFlagTestCommand(t, url, "text", addImportFlags)
+
+	require.NoError(t, importArchiveCmd.RunE(cmd, []string{path}))
+	assert.Equal(t, 1, library.calls["GetMyUserBooks"])
+	assert.Equal(t, 2, library.calls["InsertUserBook"])
+}
+
+func TestReadArchive_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "not JSON", content: "Title,Author\n", wantErr: "not a library archive"},
+		{name: "schema", content: `{"schema": "other", "version": 1}`, wantErr: `unknown schema "other"`},
+		{name: "newer", content: `{"schema": "hardcover-cli/library-archive", "version": 2}`, wantErr: "unsupported archive version 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readArchive(strings.NewReader(tt.content))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestArchiveRecords_SkipsOtherJournalEvents(t *testing.T) {
+	var archive libraryArchive
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"books": [{"book_id": 1, "status_id": 1}],
+		"journals": [
+			{"book_id": 1, "event": "quote", "entry": "Fear is the mind-killer."},
+			{"book_id": 1, "event": "status_want_to_read"}
+		]
+	}`), &archive))
+
+	records, err := archiveRecords(&archive)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "hardcover:1", records[0].Key)
+	require.Len(t, records[0].Journals, 1)
+	assert.Equal(t, client.JournalEventQuote, records[0].Journals[0].Event)
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// readStringFlags copies the values of the named string flags into the
+// given destinations, leaving destinations of unregistered flags untouched.
+func readStringFlags(cmd *cobra.Command, flags map[string]*string) error {
+	for name, value := range flags {
+		if cmd.Flags().Lookup(name) == nil {
+			continue
+		}
+		flagValue, err := cmd.Flags().GetString(name)
+		if err != nil {
+			return err
+		}
+		*value = flagValue
+	}
+	return nil
+}
+
+// readBoolFlags copies the values of the named bool flags into the given
+// destinations, leaving destinations of unregistered flags untouched.
+func readBoolFlags(cmd *cobra.Command, flags map[string]*bool) error {
+	for name, value := range flags {
+		if cmd.Flags().Lookup(name) == nil {
+			continue
+		}
+		flagValue, err := cmd.Flags().GetBool(name)
+		if err != nil {
+			return err
+		}
+		*value = flagValue
+	}
+	return nil
+}
+
+// optionalBoolFlag reads a bool flag that filters only when given, returning
+// nil when the flag is unregistered or was not set on the command line.
+func optionalBoolFlag(cmd *cobra.Command, name string) (*bool, error) {
+	flag := cmd.Flags().Lookup(name)
+	if flag == nil || !flag.Changed {
+		return nil, nil //nolint:nilnil // an unset flag has no value
+	}
+	value, err := cmd.Flags().GetBool(name)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// sortFlag reads a --sort flag of the form "field" or "field:asc|desc",
+// using defaultSort when the flag is not registered. It returns the field
+// and whether the order is descending.
+func sortFlag(cmd *cobra.Command, defaultSort string) (string, bool, error) {
+	sortBy := defaultSort
+	if err := readStringFlags(cmd, map[string]*string{"sort": &sortBy}); err != nil {
+		return "", false, err
+	}
+
+	field, direction, _ := strings.Cut(sortBy, ":")
+	switch direction {
+	case "", "asc":
+		return field, false, nil
+	case "desc":
+		return field, true, nil
+	default:
+		return "", false, fmt.Errorf("invalid sort direction %q in %q: use asc or desc", direction, sortBy)
+	}
+}
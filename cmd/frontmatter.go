@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// frontmatterDelimiter opens and closes the header of a document edited in
+// the user's editor.
+const frontmatterDelimiter = "---"
+
+// formatFrontmatter renders fields as a YAML header, preceded by the help
+// comment, followed by body. Empty values are left blank rather than
+// written as null.
+func formatFrontmatter(help string, fields interface{}, body string) (string, error) {
+	header, err := yaml.Marshal(fields)
+	if err != nil {
+		return "", fmt.Errorf("failed to format document: %w", err)
+	}
+
+	var document strings.Builder
+	document.WriteString(frontmatterDelimiter + "\n")
+	document.WriteString(help)
+	document.WriteString(strings.ReplaceAll(string(header), ": null\n", ":\n"))
+	document.WriteString(frontmatterDelimiter + "\n\n")
+	if body != "" {
+		document.WriteString(body + "\n")
+	}
+	return document.String(), nil
+}
+
+// parseFrontmatter splits a document into its header, decoded into fields,
+// and its trimmed body. Keys missing from the header leave fields
+// untouched, and unknown keys are rejected. A document without a header is
+// all body.
+func parseFrontmatter(text string, fields interface{}) (string, error) {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+	rest, hasHeader := strings.CutPrefix(text, frontmatterDelimiter+"\n")
+	if !hasHeader {
+		return text, nil
+	}
+	header, body, closed := strings.Cut("\n"+rest, "\n"+frontmatterDelimiter)
+	if !closed || (body != "" && body[0] != '\n') {
+		return "", fmt.Errorf("the frontmatter header must end with a %s line", frontmatterDelimiter)
+	}
+
+	decoder := yaml.NewDecoder(strings.NewReader(header))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(fields); err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("invalid frontmatter: %w", err)
+	}
+	return strings.TrimSpace(body), nil
+}
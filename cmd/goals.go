@@ -0,0 +1,541 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// daysPerWeek converts daily rates to weekly ones.
+const daysPerWeek = 7
+
+// Where a goal stands against its schedule.
+const (
+	goalStatusNotStarted = "not_started"
+	goalStatusOnTrack    = "on_track"
+	goalStatusAhead      = "ahead"
+	goalStatusBehind     = "behind"
+	goalStatusCompleted  = "completed"
+	goalStatusMissed     = "missed"
+)
+
+// goalsCmd represents the goals command.
+var goalsCmd = &cobra.Command{
+	Use:   "goals",
+	Short: "Set and track reading goals",
+	Long: `Commands for your Hardcover reading goals: read a number of books or
+pages between two dates, usually a calendar year.
+
+Goals are identified by the ID shown by "hardcover goals list".
+
+Available subcommands:
+  list       List your reading goals
+  create     Create a reading goal
+  show       Show your progress and the pace needed to reach a goal
+  archive    Archive a goal`,
+}
+
+// goalsListCmd represents the goals list command.
+var goalsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List your reading goals",
+	Long: `List your reading goals, latest ending first, with your progress and
+whether you are ahead of or behind schedule. Archived goals are only listed
+with --all.
+
+Example:
+  hardcover goals list
+  hardcover goals list --all -o json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		var all bool
+		if err := readBoolFlags(cmd, map[string]*bool{"all": &all}); err != nil {
+			return err
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		userID, err := gqlClient.CurrentUserID(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get user profile: %w", err)
+		}
+		goals, err := gqlClient.GetGoals(ctx, userID, all)
+		if err != nil {
+			return fmt.Errorf("failed to get goals: %w", err)
+		}
+
+		today := time.Now()
+		views := make([]goalView, len(goals))
+		for i := range goals {
+			views[i] = *newGoalView(&goals[i], today)
+		}
+		return render(cmd, views, func(w io.Writer) {
+			printGoalViews(w, views)
+		})
+	},
+}
+
+// goalsCreateCmd represents the goals create command.
+var goalsCreateCmd = &cobra.Command{
+	Use:   "create <target>",
+	Short: "Create a reading goal",
+	Long: `Create a goal to read <target> books, or pages with --metric pages.
+
+The goal covers the current calendar year, another year with --year, or
+any period with --start and --end.
+
+Example:
+  hardcover goals create 52
+  hardcover goals create 15000 --metric pages --year 2025
+  hardcover goals create 6 --start 2025-06-01 --end 2025-08-31 --description "Summer reading"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		input, err := goalInput(cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		goal, err := gqlClient.AddGoal(context.Background(), input)
+		if err != nil {
+			return fmt.Errorf("failed to create goal: %w", err)
+		}
+		if goal.Metric == "" {
+			// The API returned only the new goal's ID.
+			goal = &client.Goal{ID: goal.ID, Metric: input.Metric, Goal: input.Goal, StartDate: input.StartDate, EndDate: input.EndDate}
+			if input.Description != nil {
+				goal.Description = *input.Description
+			}
+		}
+
+		view := newGoalView(goal, time.Now())
+		view.Action = libraryActionAdded
+		return renderGoalChange(cmd, view)
+	},
+}
+
+// goalsShowCmd represents the goals show command.
+var goalsShowCmd = &cobra.Command{
+	Use:   "show <goal-id>",
+	Short: "Show your progress and the pace needed to reach a goal",
+	Long: `Show a reading goal: your progress, where you should be by today if you
+read at an even pace, and how many books or pages a week you need from now
+on to reach it. --refresh has Hardcover recalculate your progress first.
+
+Example:
+  hardcover goals show 12
+  hardcover goals show 12 --refresh`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var refresh bool
+		if err := readBoolFlags(cmd, map[string]*bool{"refresh": &refresh}); err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		gqlClient, goal, err := findGoal(ctx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+		if refresh {
+			refreshed, err := gqlClient.UpdateGoalProgress(ctx, goal.ID)
+			if err != nil {
+				return fmt.Errorf("failed to refresh goal progress: %w", err)
+			}
+			if refreshed.Metric != "" {
+				goal = refreshed
+			}
+		}
+
+		view := newGoalView(goal, time.Now())
+		return render(cmd, view, func(w io.Writer) {
+			printGoal(w, view)
+		})
+	},
+}
+
+// goalsArchiveCmd represents the goals archive command.
+var goalsArchiveCmd = &cobra.Command{
+	Use:   "archive <goal-id>",
+	Short: "Archive a goal",
+	Long: `Archive a reading goal, hiding it from "hardcover goals list" without
+deleting it. Archived goals are listed with --all.
+
+Example:
+  hardcover goals archive 12`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		gqlClient, goal, err := findGoal(ctx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		view := newGoalView(goal, time.Now())
+		view.Action = libraryActionUnchanged
+		if !goal.Archived {
+			archived := true
+			if _, err := gqlClient.UpdateGoal(ctx, goal.ID, &client.GoalInput{Archived: &archived}); err != nil {
+				return fmt.Errorf("failed to archive goal: %w", err)
+			}
+			view.Action, view.Archived = libraryActionUpdated, true
+		}
+		return renderGoalChange(cmd, view)
+	},
+}
+
+// findGoal looks up one of the current user's goals by the ID given on the
+// command line.
+func findGoal(ctx context.Context, cmd *cobra.Command, identifier string) (*client.Client, *client.Goal, error) {
+	id, err := strconv.Atoi(identifier)
+	if err != nil || id <= 0 {
+		return nil, nil, fmt.Errorf("invalid goal ID %q", identifier)
+	}
+
+	gqlClient, err := newAuthenticatedClient(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userID, err := gqlClient.CurrentUserID(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get user profile: %w", err)
+	}
+	goal, err := gqlClient.GetGoal(ctx, userID, id)
+	if errors.Is(err, client.ErrNotFound) {
+		return nil, nil, fmt.Errorf("goal %d %w", id, client.ErrNotFound)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get goal: %w", err)
+	}
+	return gqlClient, goal, nil
+}
+
+// goalInput builds the goal to create from the goals create flags and
+// target.
+func goalInput(cmd *cobra.Command, target string) (*client.GoalInput, error) {
+	goal, err := strconv.Atoi(target)
+	if err != nil || goal <= 0 {
+		return nil, fmt.Errorf("invalid target %q: use a positive whole number", target)
+	}
+
+	var metric, start, end, description, privacy string
+	if err := readStringFlags(cmd, map[string]*string{
+		"metric": &metric, "start": &start, "end": &end, "description": &description, "privacy": &privacy,
+	}); err != nil {
+		return nil, err
+	}
+	input := &client.GoalInput{Goal: goal}
+	if input.Metric, err = parseGoalMetric(metric); err != nil {
+		return nil, err
+	}
+	if input.StartDate, input.EndDate, err = goalPeriod(cmd, start, end); err != nil {
+		return nil, err
+	}
+	if input.PrivacySettingID, err = client.ParsePrivacySetting(privacy); err != nil {
+		return nil, err
+	}
+	if description = strings.TrimSpace(description); description != "" {
+		input.Description = &description
+	}
+	return input, nil
+}
+
+// parseGoalMetric parses the --metric flag.
+func parseGoalMetric(metric string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(metric)) {
+	case "", "book", "books":
+		return client.GoalMetricBooks, nil
+	case "page", "pages":
+		return client.GoalMetricPages, nil
+	default:
+		return "", fmt.Errorf("unknown metric %q (available: books, pages)", metric)
+	}
+}
+
+// goalPeriod returns the start and end dates of a new goal: --start and
+// --end when given, otherwise the --year, or the current year.
+func goalPeriod(cmd *cobra.Command, start, end string) (string, string, error) {
+	year := time.Now().Year()
+	if flag := cmd.Flags().Lookup("year"); flag != nil && flag.Changed {
+		if start != "" || end != "" {
+			return "", "", errors.New("give either --year or --start and --end")
+		}
+		var err error
+		if year, err = cmd.Flags().GetInt("year"); err != nil {
+			return "", "", err
+		}
+	}
+	if start == "" {
+		start = time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC).Format(time.DateOnly)
+	}
+	if end == "" {
+		end = time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).Format(time.DateOnly)
+	}
+
+	startDate, err := time.Parse(time.DateOnly, start)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid start date %q: use YYYY-MM-DD", start)
+	}
+	endDate, err := time.Parse(time.DateOnly, end)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid end date %q: use YYYY-MM-DD", end)
+	}
+	if endDate.Before(startDate) {
+		return "", "", fmt.Errorf("the end date %s is before the start date %s", end, start)
+	}
+	return start, end, nil
+}
+
+// goalView is a reading goal in the goals commands' output. Expected is
+// the progress needed by today at an even pace; RequiredPerWeek is the
+// pace needed from today to reach the goal. Action is set by the commands
+// that change a goal.
+type goalView struct {
+	Action          string  `json:"action,omitempty"`
+	ID              int     `json:"id"`
+	Title           string  `json:"title"`
+	Metric          string  `json:"metric"`
+	Unit            string  `json:"unit"`
+	Goal            int     `json:"goal"`
+	Progress        float64 `json:"progress"`
+	Percent         float64 `json:"percent"`
+	StartDate       string  `json:"start_date"`
+	EndDate         string  `json:"end_date"`
+	DaysLeft        int     `json:"days_left"`
+	Expected        float64 `json:"expected"`
+	Status          string  `json:"status"`
+	RequiredPerWeek float64 `json:"required_per_week"`
+	Archived        bool    `json:"archived"`
+}
+
+// newGoalView describes a goal's progress against its schedule as of today.
+func newGoalView(goal *client.Goal, today time.Time) *goalView {
+	view := &goalView{
+		ID:        goal.ID,
+		Title:     goal.Description,
+		Metric:    goal.Metric,
+		Unit:      goalUnit(goal.Metric),
+		Goal:      goal.Goal,
+		Progress:  goal.Progress,
+		StartDate: goal.StartDate,
+		EndDate:   goal.EndDate,
+		Archived:  goal.Archived,
+	}
+	if view.Title == "" {
+		view.Title = fmt.Sprintf("Read %d %s", goal.Goal, view.Unit)
+	}
+	if goal.Goal > 0 {
+		view.Percent = math.Min(goal.Progress/float64(goal.Goal)*percentScale, percentScale)
+	}
+
+	start, startErr := time.Parse(time.DateOnly, goal.StartDate)
+	end, endErr := time.Parse(time.DateOnly, goal.EndDate)
+	if startErr != nil || endErr != nil {
+		return view
+	}
+	scheduleGoal(view, start, end, time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC))
+	return view
+}
+
+// scheduleGoal sets where a goal should be by today, the days left, the
+// weekly pace needed to reach it and its status. Today counts as a day left
+// to read, so the expected progress covers the days before it.
+func scheduleGoal(view *goalView, start, end, today time.Time) {
+	totalDays := daysBetween(start, end) + 1
+	view.DaysLeft = min(max(daysBetween(today, end)+1, 0), totalDays)
+	view.Expected = float64(view.Goal) * float64(totalDays-view.DaysLeft) / float64(totalDays)
+
+	remaining := float64(view.Goal) - view.Progress
+	switch {
+	case remaining <= 0:
+		view.Status = goalStatusCompleted
+		return
+	case view.DaysLeft == 0:
+		view.Status = goalStatusMissed
+		return
+	}
+	view.RequiredPerWeek = remaining / float64(view.DaysLeft) * daysPerWeek
+
+	switch difference := math.Round(view.Progress - view.Expected); {
+	case today.Before(start):
+		view.Status = goalStatusNotStarted
+	case difference > 0:
+		view.Status = goalStatusAhead
+	case difference < 0:
+		view.Status = goalStatusBehind
+	default:
+		view.Status = goalStatusOnTrack
+	}
+}
+
+// daysBetween returns the whole days from one date to another.
+func daysBetween(from, to time.Time) int {
+	return int(math.Round(to.Sub(from).Hours() / hoursPerDay))
+}
+
+// goalUnit returns the plural unit a goal metric counts, e.g. "books".
+func goalUnit(metric string) string {
+	switch metric {
+	case client.GoalMetricBooks:
+		return "books"
+	case client.GoalMetricPages:
+		return "pages"
+	default:
+		return metric
+	}
+}
+
+// formatGoalAmount formats a number of books or pages, rounded to one
+// decimal place without trailing zeros.
+func formatGoalAmount(amount float64) string {
+	return strconv.FormatFloat(math.Round(amount*10)/10, 'f', -1, 64)
+}
+
+// describeGoalStatus describes where a goal stands, e.g. "3 books behind
+// schedule".
+func describeGoalStatus(view *goalView) string {
+	difference := formatGoalAmount(math.Abs(math.Round(view.Progress - view.Expected)))
+	switch view.Status {
+	case goalStatusCompleted:
+		return "Completed"
+	case goalStatusMissed:
+		return fmt.Sprintf("Ended %s %s short", formatGoalAmount(float64(view.Goal)-view.Progress), view.Unit)
+	case goalStatusNotStarted:
+		return "Starts " + view.StartDate
+	case goalStatusAhead:
+		return fmt.Sprintf("%s %s ahead of schedule", difference, view.Unit)
+	case goalStatusBehind:
+		return fmt.Sprintf("%s %s behind schedule", difference, view.Unit)
+	default:
+		return "On track"
+	}
+}
+
+// describeGoalPace describes the pace needed to reach a goal, e.g. "you
+// need 1.3 books/week to finish".
+func describeGoalPace(view *goalView) string {
+	if view.RequiredPerWeek == 0 {
+		return ""
+	}
+	format := "you need %.1f %s/week to finish"
+	if view.Metric == client.GoalMetricPages {
+		format = "you need %.0f %s/week to finish"
+	}
+	return fmt.Sprintf(format, view.RequiredPerWeek, view.Unit)
+}
+
+// describeGoalProgress formats a goal's progress, e.g. "18 of 52 books".
+func describeGoalProgress(view *goalView) string {
+	return fmt.Sprintf("%s of %d %s", formatGoalAmount(view.Progress), view.Goal, view.Unit)
+}
+
+// printGoalViews writes goals as human-readable text.
+func printGoalViews(w io.Writer, goals []goalView) {
+	if len(goals) == 0 {
+		printToStdoutf(w, "You have no reading goals. Create one with \"hardcover goals create\".\n")
+		return
+	}
+
+	printToStdoutf(w, "Reading goals (%d)\n\n", len(goals))
+	for i := range goals {
+		goal := &goals[i]
+		title := fmt.Sprintf("#%d  %s", goal.ID, goal.Title)
+		if goal.Archived {
+			title += " (archived)"
+		}
+		printToStdoutf(w, "%s\n", title)
+		printToStdoutf(w, "   %s (%.0f%%) · %s to %s\n", describeGoalProgress(goal), goal.Percent, goal.StartDate, goal.EndDate)
+		printToStdoutf(w, "   %s\n", describeGoalStatus(goal))
+		printSearchSeparator(w)
+	}
+}
+
+// printGoal writes a goal's progress and schedule as human-readable text.
+func printGoal(w io.Writer, goal *goalView) {
+	title := fmt.Sprintf("%s (goal %d)", goal.Title, goal.ID)
+	if goal.Archived {
+		title += ", archived"
+	}
+	printToStdoutf(w, "%s\n", title)
+	printToStdoutf(w, "   %s %.0f%%\n", progressBar(goal.Percent), goal.Percent)
+	printOptionalField(w, "Progress", describeGoalProgress(goal))
+
+	period := goal.StartDate + " to " + goal.EndDate
+	if goal.DaysLeft > 0 {
+		period += " (" + pluralize(goal.DaysLeft, "day", "days") + " left)"
+	}
+	printOptionalField(w, "Period", period)
+
+	status := describeGoalStatus(goal)
+	if goal.Status == goalStatusAhead || goal.Status == goalStatusBehind || goal.Status == goalStatusOnTrack {
+		status += fmt.Sprintf(" (%s expected by today)", formatGoalAmount(goal.Expected))
+	}
+	printOptionalField(w, "Status", status)
+	printOptionalField(w, "Pace", describeGoalPace(goal))
+}
+
+// renderGoalChange writes the outcome of a goal create or archive.
+func renderGoalChange(cmd *cobra.Command, view *goalView) error {
+	return render(cmd, view, func(w io.Writer) {
+		switch view.Action {
+		case libraryActionAdded:
+			printToStdoutf(w, "Created goal %d: %s, %s to %s.\n", view.ID, view.Title, view.StartDate, view.EndDate)
+			printOptionalField(w, "Pace", describeGoalPace(view))
+		case libraryActionUnchanged:
+			printToStdoutf(w, "Goal %d is already archived.\n", view.ID)
+		default:
+			printToStdoutf(w, "Archived goal %d (%q).\n", view.ID, view.Title)
+		}
+	})
+}
+
+// addGoalsListFlags registers the flags of the goals list command.
+func addGoalsListFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("all", false, "include archived goals")
+}
+
+// addGoalsCreateFlags registers the flags of the goals create command.
+func addGoalsCreateFlags(cmd *cobra.Command) {
+	cmd.Flags().String("metric", "books", "what the goal counts (books, pages)")
+	cmd.Flags().Int("year", 0, "the calendar year the goal covers (default this year)")
+	cmd.Flags().String("start", "", "the first day of the goal (YYYY-MM-DD)")
+	cmd.Flags().String("end", "", "the last day of the goal (YYYY-MM-DD)")
+	cmd.Flags().String("description", "", "a name for the goal, e.g. \"Summer reading\"")
+	cmd.Flags().String("privacy", client.PrivacyPublic.String(),
+		"who can see the goal ("+strings.Join(client.PrivacySettingNames(), ", ")+")")
+}
+
+// addGoalsShowFlags registers the flags of the goals show command.
+func addGoalsShowFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("refresh", false, "recalculate your progress before showing the goal")
+}
+
+// setupGoalsCommands registers the goals commands with the root command.
+func setupGoalsCommands() {
+	addGoalsListFlags(goalsListCmd)
+	goalsCmd.AddCommand(goalsListCmd)
+	addGoalsCreateFlags(goalsCreateCmd)
+	goalsCmd.AddCommand(goalsCreateCmd)
+	addGoalsShowFlags(goalsShowCmd)
+	goalsCmd.AddCommand(goalsShowCmd)
+	goalsCmd.AddCommand(goalsArchiveCmd)
+	rootCmd.AddCommand(goalsCmd)
+}
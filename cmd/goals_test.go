@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/client"
+)
+
+// pastGoals are a completed 2024 book goal and an archived 2023 page goal.
+func pastGoals() []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"id": 12, "metric": "book", "goal": 52, "progress": 48, "description": "2024 Reading Goal",
+			"start_date": "2024-01-01", "end_date": "2024-12-31", "archived": false,
+		},
+		{
+			"id": 13, "metric": "pages", "goal": 15000, "progress": 12000,
+			"start_date": "2023-01-01", "end_date": "2023-12-31", "archived": true,
+		},
+	}
+}
+
+// findGoals answers GetGoals, filtering by ID and archived when the query
+// does.
+func (l *fakeLibrary) findGoals(variables map[string]interface{}) []interface{} {
+	where := variables["where"].(map[string]interface{})
+	goals := []interface{}{}
+	for _, goal := range l.goals {
+		if id, ok := where["id"].(map[string]interface{}); ok && float64(goal["id"].(int)) != id["_eq"] {
+			continue
+		}
+		if archived, ok := where["archived"].(map[string]interface{}); ok && goal["archived"] != archived["_eq"] {
+			continue
+		}
+		goals = append(goals, goal)
+	}
+	return goals
+}
+
+// mutateGoal applies a goal mutation. Refreshing a goal's progress sets it
+// to 55.
+func (l *fakeLibrary) mutateGoal(operation string, variables map[string]interface{}) interface{} {
+	switch operation {
+	case "InsertGoal":
+		l.nextID++
+		goal := map[string]interface{}{"id": l.nextID}
+		l.apply(goal, variables["goal"].(map[string]interface{}))
+		l.goals = append(l.goals, goal)
+		return map[string]interface{}{"insert_goal": map[string]interface{}{"id": l.nextID, "goal": goal}}
+	case "UpdateGoal":
+		return map[string]interface{}{"update_goal": map[string]interface{}{"id": variables["id"]}}
+	default:
+		goal := l.findGoals(map[string]interface{}{"where": map[string]interface{}{"id": map[string]interface{}{"_eq": variables["id"]}}})[0]
+		goal.(map[string]interface{})["progress"] = 55
+		return map[string]interface{}{"update_goal_progress": map[string]interface{}{"id": variables["id"], "goal": goal}}
+	}
+}
+
+func TestNewGoalView_Schedule(t *testing.T) {
+	july := time.Date(2026, time.July, 2, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		metric     string
+		goal       int
+		progress   float64
+		today      time.Time
+		wantStatus string
+		wantText   string
+		wantPace   string
+	}{
+		{"behind", "book", 52, 20, july, "behind", "6 books behind schedule", "you need 1.2 books/week to finish"},
+		{"ahead", "book", 52, 30, july, "ahead", "4 books ahead of schedule", "you need 0.8 books/week to finish"},
+		{"on track", "book", 52, 26, july, "on_track", "On track", "you need 1.0 books/week to finish"},
+		{"pages", "pages", 15000, 7000, july, "behind", "479 pages behind schedule", "you need 306 pages/week to finish"},
+		{"completed", "book", 52, 52, july, "completed", "Completed", ""},
+		{"missed", "book", 52, 40, time.Date(2027, time.January, 5, 0, 0, 0, 0, time.UTC), "missed", "Ended 12 books short", ""},
+		{"not started", "book", 52, 0, time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC),
+			"not_started", "Starts 2026-01-01", "you need 1.0 books/week to finish"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view := newGoalView(&client.Goal{
+				ID: 1, Metric: tt.metric, Goal: tt.goal, Progress: tt.progress,
+				StartDate: "2026-01-01", EndDate: "2026-12-31",
+			}, tt.today)
+
+			assert.Equal(t, tt.wantStatus, view.Status)
+			assert.Equal(t, tt.wantText, describeGoalStatus(view))
+			assert.Equal(t, tt.wantPace, describeGoalPace(view))
+		})
+	}
+}
+
+func TestNewGoalView_DaysLeftAndExpected(t *testing.T) {
+	view := newGoalView(&client.Goal{
+		Metric: "book", Goal: 52, Progress: 20, StartDate: "2026-01-01", EndDate: "2026-12-31",
+	}, time.Date(2026, time.July, 2, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, "Read 52 books", view.Title)
+	assert.Equal(t, 183, view.DaysLeft)
+	assert.InDelta(t, 25.9, view.Expected, 0.05)
+	assert.InDelta(t, 38.5, view.Percent, 0.05)
+}
+
+func TestGoalsCreateCmd(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	cmd, output := newFlagTestCommand(t, url, "text", addGoalsCreateFlags,
+		"--metric", "pages", "--year", "2025", "--privacy", "followers")
+
+	require.NoError(t, goalsCreateCmd.RunE(cmd, []string{"15000"}))
+
+	assert.Equal(t, "Created goal 501: Read 15000 pages, 2025-01-01 to 2025-12-31.\n", output.String())
+	require.Equal(t, []string{"InsertGoal"}, library.operations())
+	assert.Equal(t, map[string]interface{}{
+		"metric": "pages", "goal": float64(15000), "start_date": "2025-01-01", "end_date": "2025-12-31",
+		"privacy_setting_id": float64(2), "conditions": map[string]interface{}{},
+	}, library.mutations[0].Variables["goal"])
+}
+
+func TestGoalsCreateCmd_Invalid(t *testing.T) {
+	tests := []struct {
+		target  string
+		flags   []string
+		wantErr string
+	}{
+		{target: "ten", wantErr: `invalid target "ten"`},
+		{target: "10", flags: []string{"--metric", "hours"}, wantErr: `unknown metric "hours"`},
+		{target: "10", flags: []string{"--year", "2025", "--start", "2025-02-01"}, wantErr: "give either --year or --start and --end"},
+		{target: "10", flags: []string{"--start", "2025-06-01", "--end", "2025-05-01"}, wantErr: "is before the start date"},
+		{target: "10", flags: []string{"--end", "June"}, wantErr: `invalid end date "June"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(append([]string{tt.target}, tt.flags...), " "), func(t *testing.T) {
+			library, url := newFakeLibrary(t, nil)
+			cmd, _ := newFlagTestCommand(t, url, "text", addGoalsCreateFlags, tt.flags...)
+
+			err := goalsCreateCmd.RunE(cmd, []string{tt.target})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Empty(t, library.mutations)
+		})
+	}
+}
+
+func TestGoalsListCmd(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.goals = pastGoals()
+	cmd, output := newFlagTestCommand(t, url, "text", addGoalsListFlags)
+
+	require.NoError(t, goalsListCmd.RunE(cmd, nil))
+
+	assert.Equal(t, "Reading goals (1)\n\n#12  2024 Reading Goal\n"+
+		"   48 of 52 books (92%) · 2024-01-01 to 2024-12-31\n   Ended 4 books short\n\n"+
+		strings.Repeat("-", 29)+"\n", output.String())
+
+	require.NoError(t, cmd.Flags().Parse([]string{"--all"}))
+	output.Reset()
+	require.NoError(t, goalsListCmd.RunE(cmd, nil))
+	assert.Contains(t, output.String(), "#13  Read 15000 pages (archived)\n   12000 of 15000 pages (80%)")
+	require.Len(t, library.queries, 2)
+	assert.Equal(t, map[string]interface{}{"_eq": false}, library.queries[0].Variables["where"].(map[string]interface{})["archived"])
+	assert.NotContains(t, library.queries[1].Variables["where"], "archived")
+}
+
+func TestGoalsShowCmd_Refresh(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.goals = pastGoals()
+	cmd, output := newFlagTestCommand(t, url, "text", addGoalsShowFlags, "--refresh")
+
+	require.NoError(t, goalsShowCmd.RunE(cmd, []string{"12"}))
+
+	assert.Equal(t, "2024 Reading Goal (goal 12)\n   [████████████████████] 100%\n"+
+		"   Progress: 55 of 52 books\n   Period: 2024-01-01 to 2024-12-31\n   Status: Completed\n", output.String())
+	require.Equal(t, []string{"UpdateGoalProgress"}, library.operations())
+	assert.Equal(t, float64(12), library.mutations[0].Variables["id"])
+}
+
+func TestGoalsShowCmd_NotFound(t *testing.T) {
+	_, url := newFakeLibrary(t, nil)
+	cmd, _ := newBookTestCommand(url, "text")
+
+	err := goalsShowCmd.RunE(cmd, []string{"99"})
+	require.Error(t, err)
+	assert.Equal(t, "goal 99 not found", err.Error())
+}
+
+func TestGoalsArchiveCmd(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.goals = pastGoals()
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, goalsArchiveCmd.RunE(cmd, []string{"12"}))
+	require.NoError(t, goalsArchiveCmd.RunE(cmd, []string{"13"}))
+
+	assert.Equal(t, "Archived goal 12 (\"2024 Reading Goal\").\nGoal 13 is already archived.\n", output.String())
+	require.Equal(t, []string{"UpdateGoal"}, library.operations())
+	assert.Equal(t, float64(12), library.mutations[0].Variables["id"])
+	assert.Equal(t, map[string]interface{}{"archived": true}, library.mutations[0].Variables["goal"])
+}
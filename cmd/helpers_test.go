@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/testutil"
+)
+
+// recordingServer answers every request with data and records the last
+// request in lastRequest. It returns the server's URL.
+func recordingServer(t *testing.T, lastRequest *client.GraphQLRequest, data interface{}) string {
+	t.Helper()
+
+	server := testutil.CreateTestServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(lastRequest); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{"data": data}); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	})
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// newBookTestCommand creates a command configured to talk to serverURL.
+func newBookTestCommand(serverURL, format string) (*cobra.Command, *bytes.Buffer) {
+	cfg := testutil.SetupTestConfig(&testutil.TestConfig{
+		APIKey:  "test-api-key",
+		BaseURL: serverURL,
+	})
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("output", format, "")
+	cmd.SetContext(testutil.WithTestConfigAdapter(context.Background(), cfg))
+
+	var output bytes.Buffer
+	cmd.SetOut(&output)
+	return cmd, &output
+}
+
+// newFlagTestCommand creates a command configured to talk to serverURL, with
+// the flags registered by addFlags parsed from args.
+func newFlagTestCommand(
+	t *testing.T, serverURL, format string, addFlags func(*cobra.Command), args ...string,
+) (*cobra.Command, *bytes.Buffer) {
+	t.Helper()
+
+	cmd, output := newBookTestCommand(serverURL, format)
+	addFlags(cmd)
+	require.NoError(t, cmd.Flags().Parse(args))
+	return cmd, output
+}
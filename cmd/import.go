@@ -0,0 +1,810 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/isbn"
+	"hardcover-cli/internal/output"
+)
+
+// Outcomes of importing a row.
+const (
+	importActionImported    = "imported"
+	importActionWouldImport = "would_import"
+	importActionResumed     = "resumed"
+	importActionWouldResume = "would_resume"
+	importActionInLibrary   = "in_library"
+	importActionDone        = "already_imported"
+	importActionUnmatched   = "unmatched"
+	importActionFailed      = "failed"
+)
+
+// How a row was matched to a Hardcover book.
+const (
+	importMatchID     = "id"
+	importMatchISBN   = "isbn"
+	importMatchSearch = "search"
+	importMatchNone   = "none"
+)
+
+// importStatePartial is the action of a row in a state file whose book was
+// added but whose reads or journals were not all written.
+const importStatePartial = "partial"
+
+// importSearchResults is the number of search hits checked for a row that
+// no ISBN matched.
+const importSearchResults = 5
+
+// importStateFileMode is the permission of state files, which hold nothing
+// that needs sharing.
+const importStateFileMode = 0o600
+
+// importCmd represents the import command.
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import your reading history from other services",
+	Long: `Commands for importing your reading history into your Hardcover library.
+
+Each row of an export is matched to a Hardcover book by ISBN, falling back
+to a search by title and author, then added to your shelves with its
+status, rating, review and reads. Books already in your library are left
+unchanged.
+
+Run with --dry-run first to see how each row matches without changing
+anything. Imports record their progress in a state file next to the
+export, so an interrupted import can be run again and resumes where it
+stopped.
+
+Available subcommands:
+  goodreads          Import a Goodreads library export
+  storygraph         Import a StoryGraph export
+  hardcover-archive  Restore an archive made by "hardcover export"`,
+}
+
+// importRead is one reading of a book in an export. Dates are YYYY-MM-DD,
+// or empty when unknown. Reads without an edition use the row's.
+type importRead struct {
+	EditionID       *int
+	StartedAt       string
+	PausedAt        string
+	FinishedAt      string
+	ProgressPages   int
+	ProgressSeconds int
+}
+
+// input converts the read to a read input, defaulting to editionID.
+func (r *importRead) input(editionID *int) *client.UserBookReadInput {
+	input := &client.UserBookReadInput{EditionID: editionID}
+	if r.EditionID != nil {
+		input.EditionID = r.EditionID
+	}
+	if r.StartedAt != "" {
+		input.StartedAt = &r.StartedAt
+	}
+	if r.PausedAt != "" {
+		input.PausedAt = &r.PausedAt
+	}
+	if r.FinishedAt != "" {
+		input.FinishedAt = &r.FinishedAt
+	}
+	if r.ProgressPages > 0 {
+		input.ProgressPages = &r.ProgressPages
+	}
+	if r.ProgressSeconds > 0 {
+		input.ProgressSeconds = &r.ProgressSeconds
+	}
+	return input
+}
+
+// importRecord is a row of an export in a form shared by every source. Key
+// identifies the row across runs for the state file. Rows with a BookID
+// are not matched: the source already knows the Hardcover book. Moods are
+// only reported: Hardcover has no per-reader moods to import them into.
+type importRecord struct {
+	Line              int
+	Key               string
+	Title             string
+	Author            string
+	ISBNs             []isbn.ISBN
+	BookID            int
+	EditionID         *int
+	Status            client.UserBookStatus
+	Rating            float64
+	DateAdded         string
+	Reads             []importRead
+	Review            string
+	ReviewHasSpoilers bool
+	ReviewedAt        string
+	PrivateNotes      string
+	Privacy           client.PrivacySetting
+	Owned             bool
+	Format            client.ReadingFormat
+	Moods             []string
+	Journals          []client.ReadingJournalInput
+}
+
+// importRowView is the structured form of a row in the import commands'
+// output.
+type importRowView struct {
+	Line         int      `json:"line"`
+	Title        string   `json:"title"`
+	Author       string   `json:"author"`
+	Match        string   `json:"match,omitempty"`
+	BookID       int      `json:"book_id,omitempty"`
+	EditionID    int      `json:"edition_id,omitempty"`
+	MatchedTitle string   `json:"matched_title,omitempty"`
+	Status       string   `json:"status"`
+	Rating       float64  `json:"rating,omitempty"`
+	Reads        int      `json:"reads"`
+	Journals     int      `json:"journals,omitempty"`
+	Moods        []string `json:"moods,omitempty"`
+	Action       string   `json:"action"`
+	UserBookID   int      `json:"user_book_id,omitempty"`
+	Error        string   `json:"error,omitempty"`
+}
+
+// importState records the rows of an export that are finished, keyed by
+// importRecord.Key, so that an interrupted import can resume.
+type importState struct {
+	path   string
+	Source string                    `json:"source"`
+	Rows   map[string]importStateRow `json:"rows"`
+}
+
+// importStateRow is a row in a state file. Partial rows also record the
+// edition of their reads and how many of the row's reads and journals were
+// written, so that a rerun writes only the rest.
+type importStateRow struct {
+	Action     string `json:"action"`
+	BookID     int    `json:"book_id"`
+	UserBookID int    `json:"user_book_id"`
+	EditionID  *int   `json:"edition_id,omitempty"`
+	Reads      int    `json:"reads,omitempty"`
+	Journals   int    `json:"journals,omitempty"`
+}
+
+// loadImportState reads the state file at path, starting an empty state
+// when it does not exist yet.
+func loadImportState(path, source string) (*importState, error) {
+	state := &importState{path: path, Source: source, Rows: make(map[string]importStateRow)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read import state: %w", err)
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to read import state %s: %w", path, err)
+	}
+	if state.Source != source {
+		return nil, fmt.Errorf("import state %s belongs to a %s import; use --state to choose another file", path, state.Source)
+	}
+	if state.Rows == nil {
+		state.Rows = make(map[string]importStateRow)
+	}
+	return state, nil
+}
+
+// record sets a row's progress and saves the state, replacing the file
+// in one step so an interruption cannot leave it half written.
+func (s *importState) record(key string, row importStateRow) error {
+	s.Rows[key] = row
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	temp := s.path + ".tmp"
+	if err := os.WriteFile(temp, data, importStateFileMode); err != nil {
+		return fmt.Errorf("failed to save import state: %w", err)
+	}
+	if err := os.Rename(temp, s.path); err != nil {
+		return fmt.Errorf("failed to save import state: %w", err)
+	}
+	return nil
+}
+
+// importMatch is the book, and the edition when known, that a row matched.
+type importMatch struct {
+	Method    string
+	BookID    int
+	EditionID *int
+	Title     string
+}
+
+// importer adds the rows of an export to the user's library.
+type importer struct {
+	ctx      context.Context
+	client   *client.Client
+	state    *importState
+	dryRun   bool
+	editions []client.EditionDetail
+	// shelved holds the user's entries for the books seen so far, with nil
+	// for books that are not in the library.
+	shelved map[int]*client.UserBook
+}
+
+// newImporter prepares to import records, looking up all of their ISBNs
+// and the user's entries for the books known or found in a few requests.
+func newImporter(
+	ctx context.Context, c *client.Client, state *importState, records []importRecord, dryRun bool,
+) (*importer, error) {
+	im := &importer{ctx: ctx, client: c, state: state, dryRun: dryRun, shelved: make(map[int]*client.UserBook)}
+
+	var numbers []isbn.ISBN
+	var bookIDs []int
+	for i := range records {
+		if _, done := state.Rows[records[i].Key]; done {
+			continue
+		}
+		if records[i].BookID != 0 {
+			bookIDs = append(bookIDs, records[i].BookID)
+		} else {
+			numbers = append(numbers, records[i].ISBNs...)
+		}
+	}
+	if len(numbers) > 0 {
+		editions, err := c.GetEditionsByISBN(ctx, numbers)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up ISBNs: %w", err)
+		}
+		im.editions = editions
+		for i := range editions {
+			if editions[i].Book != nil {
+				bookIDs = append(bookIDs, editions[i].Book.ID)
+			}
+		}
+	}
+	if len(bookIDs) == 0 {
+		return im, nil
+	}
+	if err := im.loadShelved(bookIDs); err != nil {
+		return nil, err
+	}
+	return im, nil
+}
+
+// loadShelved fetches the user's entries for the given books. It fails on
+// any error the API reports instead of keeping partial data: a book whose
+// entry failed to load would look unshelved and be added a second time.
+func (im *importer) loadShelved(bookIDs []int) error {
+	userBooks, err := im.client.GetMyUserBooks(im.ctx, bookIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get library entries: %w", err)
+	}
+	for _, id := range bookIDs {
+		if entry, ok := userBooks[id]; ok {
+			im.shelved[id] = &entry
+		} else {
+			im.shelved[id] = nil
+		}
+	}
+	return nil
+}
+
+// importRow matches and imports one record. Problems with the row are
+// reported in the view; the error is only set when the state file cannot be
+// saved.
+func (im *importer) importRow(record *importRecord) (*importRowView, error) {
+	view := &importRowView{
+		Line:     record.Line,
+		Title:    record.Title,
+		Author:   record.Author,
+		Status:   record.Status.Slug(),
+		Rating:   record.Rating,
+		Reads:    len(record.Reads),
+		Journals: len(record.Journals),
+		Moods:    record.Moods,
+	}
+	if done, ok := im.state.Rows[record.Key]; ok {
+		if done.Action == importStatePartial {
+			return im.resume(record, view, done)
+		}
+		view.Action, view.BookID, view.UserBookID = importActionDone, done.BookID, done.UserBookID
+		return view, nil
+	}
+
+	match, err := im.match(record)
+	if err != nil {
+		return view.fail(err), nil
+	}
+	view.Match = match.Method
+	if match.Method == importMatchNone {
+		view.Action = importActionUnmatched
+		return view, nil
+	}
+	view.BookID, view.MatchedTitle = match.BookID, match.Title
+	if match.EditionID != nil {
+		view.EditionID = *match.EditionID
+	}
+
+	if _, known := im.shelved[match.BookID]; !known {
+		if err := im.loadShelved([]int{match.BookID}); err != nil {
+			return view.fail(err), nil
+		}
+	}
+	if entry := im.shelved[match.BookID]; entry != nil {
+		view.Action, view.UserBookID = importActionInLibrary, entry.ID
+		return view, im.finish(record, view)
+	}
+	if im.dryRun {
+		view.Action = importActionWouldImport
+		return view, nil
+	}
+
+	added, err := im.add(record, match)
+	if err != nil {
+		return view.fail(err), nil
+	}
+	view.UserBookID = added.ID
+	return im.complete(record, view, importStateRow{
+		Action:     importStatePartial,
+		BookID:     match.BookID,
+		UserBookID: added.ID,
+		EditionID:  match.EditionID,
+	}, importActionImported)
+}
+
+// resume finishes a row whose book an earlier run added without all of its
+// reads and journals.
+func (im *importer) resume(record *importRecord, view *importRowView, progress importStateRow) (*importRowView, error) {
+	view.BookID, view.UserBookID = progress.BookID, progress.UserBookID
+	if progress.EditionID != nil {
+		view.EditionID = *progress.EditionID
+	}
+	if im.dryRun {
+		view.Action = importActionWouldResume
+		return view, nil
+	}
+	return im.complete(record, view, progress, importActionResumed)
+}
+
+// complete writes the reads and journals of a shelved row that progress
+// does not count as written, saving the progress after each so that a row
+// that fails or is interrupted resumes where it stopped. The row is then
+// finished with action.
+func (im *importer) complete(
+	record *importRecord, view *importRowView, progress importStateRow, action string,
+) (*importRowView, error) {
+	if err := im.state.record(record.Key, progress); err != nil {
+		return view, err
+	}
+	for progress.Reads < len(record.Reads) {
+		read := record.Reads[progress.Reads].input(progress.EditionID)
+		if _, err := im.client.AddUserBookRead(im.ctx, progress.UserBookID, read); err != nil {
+			return view.fail(fmt.Errorf("added the book but failed to record its reads: %w", err)), nil
+		}
+		progress.Reads++
+		if err := im.state.record(record.Key, progress); err != nil {
+			return view, err
+		}
+	}
+	for progress.Journals < len(record.Journals) {
+		journal := record.Journals[progress.Journals]
+		journal.BookID = progress.BookID
+		if _, err := im.client.AddReadingJournal(im.ctx, &journal); err != nil {
+			return view.fail(fmt.Errorf("added the book but failed to restore its journal: %w", err)), nil
+		}
+		progress.Journals++
+		if err := im.state.record(record.Key, progress); err != nil {
+			return view, err
+		}
+	}
+	view.Action = action
+	return view, im.finish(record, view)
+}
+
+// fail marks the row as failed with err.
+func (v *importRowView) fail(err error) *importRowView {
+	v.Action, v.Error = importActionFailed, err.Error()
+	return v
+}
+
+// finish records a row in the state file, except on a dry run.
+func (im *importer) finish(record *importRecord, view *importRowView) error {
+	if im.dryRun {
+		return nil
+	}
+	return im.state.record(record.Key, importStateRow{Action: view.Action, BookID: view.BookID, UserBookID: view.UserBookID})
+}
+
+// match finds the book for a record, first by its ISBNs and then by
+// searching for its title and author.
+func (im *importer) match(record *importRecord) (*importMatch, error) {
+	if record.BookID != 0 {
+		return &importMatch{Method: importMatchID, BookID: record.BookID, EditionID: record.EditionID, Title: record.Title}, nil
+	}
+	for _, number := range record.ISBNs {
+		for i := range im.editions {
+			edition := &im.editions[i]
+			if edition.Book != nil && edition.Matches(number) {
+				return &importMatch{Method: importMatchISBN, BookID: edition.Book.ID, EditionID: &edition.ID, Title: edition.Book.Title}, nil
+			}
+		}
+	}
+
+	query := strings.TrimSpace(importTitle(record.Title) + " " + record.Author)
+	if query == "" {
+		return &importMatch{Method: importMatchNone}, nil
+	}
+	results, err := im.client.SearchBooks(im.ctx, query, &client.SearchOptions{PerPage: importSearchResults})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for %q: %w", query, err)
+	}
+	for _, book := range results.Documents() {
+		if !sameImportName(book.Title, record.Title) || !hasImportAuthor(book.AuthorNames, record.Author) {
+			continue
+		}
+		id, err := strconv.Atoi(book.ID)
+		if err != nil {
+			continue
+		}
+		return &importMatch{Method: importMatchSearch, BookID: id, Title: book.Title}, nil
+	}
+	return &importMatch{Method: importMatchNone}, nil
+}
+
+// add shelves the matched book with the record's details.
+func (im *importer) add(record *importRecord, match *importMatch) (*client.UserBook, error) {
+	added, err := im.client.AddUserBook(im.ctx, record.input(match))
+	if err != nil {
+		return nil, fmt.Errorf("failed to add book: %w", err)
+	}
+	im.shelved[match.BookID] = added
+	return added, nil
+}
+
+// input builds the shelf entry to add for the record's matched book.
+func (r *importRecord) input(match *importMatch) *client.UserBookInput {
+	input := &client.UserBookInput{
+		BookID:           match.BookID,
+		StatusID:         r.Status,
+		EditionID:        match.EditionID,
+		ReadingFormatID:  r.Format,
+		PrivacySettingID: r.Privacy,
+	}
+	if r.Rating > 0 {
+		input.Rating = &r.Rating
+	}
+	if r.Review != "" {
+		input.ReviewRaw = &r.Review
+	}
+	if r.ReviewHasSpoilers {
+		input.ReviewHasSpoilers = &r.ReviewHasSpoilers
+	}
+	if r.ReviewedAt != "" {
+		input.ReviewedAt = &r.ReviewedAt
+	}
+	if r.PrivateNotes != "" {
+		input.PrivateNotes = &r.PrivateNotes
+	}
+	if r.DateAdded != "" {
+		input.DateAdded = &r.DateAdded
+	}
+	if r.Owned {
+		input.Owned = &r.Owned
+	}
+	return input
+}
+
+// importTitle drops a trailing series note such as "(Dune, #1)" from a
+// title.
+func importTitle(title string) string {
+	title = strings.TrimSpace(title)
+	if strings.HasSuffix(title, ")") {
+		if open := strings.LastIndex(title, " ("); open > 0 {
+			title = title[:open]
+		}
+	}
+	return title
+}
+
+// sameImportName reports whether two titles or names are the same, ignoring
+// case, punctuation, spacing, series notes and subtitles.
+func sameImportName(a, b string) bool {
+	return normalizeImportName(a) == normalizeImportName(b)
+}
+
+// hasImportAuthor reports whether author is one of names. Rows without an
+// author match any book.
+func hasImportAuthor(names []string, author string) bool {
+	if strings.TrimSpace(author) == "" {
+		return true
+	}
+	for _, name := range names {
+		if sameImportName(name, author) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeImportName reduces a title or name to lowercase words.
+func normalizeImportName(name string) string {
+	name = importTitle(name)
+	if colon := strings.Index(name, ":"); colon > 0 {
+		name = name[:colon]
+	}
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
+
+// importSource is a kind of export the import commands read.
+type importSource struct {
+	name  string
+	parse func(r io.Reader) ([]importRecord, error)
+	// restore, when set, runs after the rows to restore what does not belong
+	// to a row, reporting to w.
+	restore func(im *importer, w io.Writer) error
+}
+
+// runImport imports the export at path, streaming a line per row and ending
+// with a summary.
+func runImport(cmd *cobra.Command, source *importSource, path string) error {
+	var dryRun bool
+	if err := readBoolFlags(cmd, map[string]*bool{"dry-run": &dryRun}); err != nil {
+		return err
+	}
+	records, err := readImportFile(path, source.parse)
+	if err != nil {
+		return err
+	}
+	state, err := openImportState(cmd, source, path)
+	if err != nil {
+		return err
+	}
+
+	gqlClient, err := newAuthenticatedClient(cmd)
+	if err != nil {
+		return err
+	}
+	im, err := newImporter(context.Background(), gqlClient, state, records, dryRun)
+	if err != nil {
+		return err
+	}
+
+	stream, err := newStream(cmd)
+	if err != nil {
+		return err
+	}
+	counts, err := im.importRows(stream, records)
+	if err != nil {
+		return err
+	}
+
+	report := io.Discard
+	if stream.Format() == output.FormatText {
+		report = cmd.OutOrStdout()
+	}
+	if source.restore != nil {
+		if err := source.restore(im, report); err != nil {
+			return err
+		}
+	}
+	if stream.Format() == output.FormatText {
+		printImportSummary(report, len(records), counts, dryRun)
+	}
+	if counts[importActionFailed] > 0 {
+		return fmt.Errorf("%d of %d rows failed to import; run the command again to retry them",
+			counts[importActionFailed], len(records))
+	}
+	return nil
+}
+
+// openImportState loads the state file chosen with --state, which defaults
+// to one next to the export at path.
+func openImportState(cmd *cobra.Command, source *importSource, path string) (*importState, error) {
+	var statePath string
+	if err := readStringFlags(cmd, map[string]*string{"state": &statePath}); err != nil {
+		return nil, err
+	}
+	if statePath == "" {
+		statePath = path + ".hardcover-import.json"
+	}
+	return loadImportState(statePath, source.name)
+}
+
+// importRows imports each record, writing its outcome to stream, and
+// returns the number of rows with each action.
+func (im *importer) importRows(stream *output.Stream, records []importRecord) (map[string]int, error) {
+	counts := make(map[string]int)
+	for i := range records {
+		view, err := im.importRow(&records[i])
+		if err != nil {
+			return nil, err
+		}
+		counts[view.Action]++
+		if err := stream.Write(withText([]importRowView{*view}, func(w io.Writer) {
+			printImportRow(w, view)
+		})); err != nil {
+			return nil, err
+		}
+	}
+	return counts, stream.Close()
+}
+
+// readImportFile opens and parses an export.
+func readImportFile(path string, parse func(r io.Reader) ([]importRecord, error)) ([]importRecord, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open export: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	records, err := parse(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return records, nil
+}
+
+// importCSV is an export in CSV form with columns looked up by name.
+type importCSV struct {
+	columns map[string]int
+	rows    [][]string
+	lines   []int
+}
+
+// readImportCSV reads a CSV export, failing when any of the required
+// columns is missing.
+func readImportCSV(r io.Reader, required ...string) (*importCSV, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("the file is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data := &importCSV{columns: make(map[string]int)}
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		data.columns[name] = i
+	}
+	for _, name := range required {
+		if _, ok := data.columns[name]; !ok {
+			return nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	for {
+		row, readErr := reader.Read()
+		if errors.Is(readErr, io.EOF) {
+			return data, nil
+		}
+		if readErr != nil {
+			return nil, readErr
+		}
+		line, _ := reader.FieldPos(0)
+		data.rows = append(data.rows, row)
+		data.lines = append(data.lines, line)
+	}
+}
+
+// value returns a row's value in the named column, or "" when the column
+// or value is missing.
+func (c *importCSV) value(row []string, column string) string {
+	i, ok := c.columns[column]
+	if !ok || i >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[i])
+}
+
+// parseImportDate converts a date in one of the given layouts to
+// YYYY-MM-DD. Empty values stay empty.
+func parseImportDate(value string, layouts ...string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	for _, layout := range layouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date.Format(time.DateOnly), nil
+		}
+	}
+	return "", fmt.Errorf("invalid date %q", value)
+}
+
+// printImportRow writes a row's outcome as a line of text.
+func printImportRow(w io.Writer, view *importRowView) {
+	title := view.Title
+	if view.Author != "" {
+		title += " by " + view.Author
+	}
+
+	var outcome string
+	switch view.Action {
+	case importActionDone:
+		outcome = "already imported"
+	case importActionUnmatched:
+		outcome = "no match found"
+	case importActionFailed:
+		outcome = "failed: " + view.Error
+	case importActionResumed:
+		outcome = fmt.Sprintf("finished the import of book %d begun by an earlier run", view.BookID)
+	case importActionWouldResume:
+		outcome = fmt.Sprintf("would finish the import of book %d begun by an earlier run", view.BookID)
+	default:
+		outcome = describeImportMatch(view) + ", " + strings.ReplaceAll(view.Action, "_", " ")
+	}
+	printToStdoutf(w, "Line %d: %s: %s\n", view.Line, title, outcome)
+}
+
+// describeImportMatch describes the book a row matched.
+func describeImportMatch(view *importRowView) string {
+	match := "matched"
+	if view.Match == importMatchSearch {
+		match = "matched by search"
+	} else if view.Match == importMatchISBN {
+		match = "matched by ISBN"
+	}
+	target := fmt.Sprintf("%q (book %d", view.MatchedTitle, view.BookID)
+	if view.EditionID != 0 {
+		target += fmt.Sprintf(", edition %d", view.EditionID)
+	}
+	return match + " to " + target + ")"
+}
+
+// printImportSummary writes the totals of an import.
+func printImportSummary(w io.Writer, total int, counts map[string]int, dryRun bool) {
+	if dryRun {
+		printToStdoutf(w, "\nDry run: %d of %d rows would be imported. Nothing was changed.\n",
+			counts[importActionWouldImport], total)
+	} else {
+		printToStdoutf(w, "\nImported %d of %d rows.\n", counts[importActionImported], total)
+	}
+
+	notes := []struct {
+		action string
+		label  string
+	}{
+		{importActionInLibrary, "Already in your library"},
+		{importActionDone, "Imported by an earlier run"},
+		{importActionResumed, "Finished from an earlier run"},
+		{importActionWouldResume, "Would be finished from an earlier run"},
+		{importActionUnmatched, "Not found on Hardcover"},
+		{importActionFailed, "Failed"},
+	}
+	for _, note := range notes {
+		if counts[note.action] > 0 {
+			printToStdoutf(w, "   %s: %d\n", note.label, counts[note.action])
+		}
+	}
+}
+
+// addImportFlags registers the flags shared by the import commands.
+func addImportFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("dry-run", false, "report how each row matches without changing anything")
+	cmd.Flags().String("state", "", "file recording the import's progress (default <file>.hardcover-import.json)")
+}
+
+// setupImportCommands registers the import commands with the root command.
+func setupImportCommands() {
+	addImportFlags(importGoodreadsCmd)
+	importCmd.AddCommand(importGoodreadsCmd)
+	addImportFlags(importStorygraphCmd)
+	importCmd.AddCommand(importStorygraphCmd)
+	addImportFlags(importArchiveCmd)
+	importCmd.AddCommand(importArchiveCmd)
+	rootCmd.AddCommand(importCmd)
+}
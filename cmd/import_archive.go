@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// importArchiveCmd represents the import hardcover-archive command.
+var importArchiveCmd = &cobra.Command{
+	Use:   "hardcover-archive <file.json>",
+	Short: `Restore an archive written by "hardcover export"`,
+	Long: `Restore a JSON archive written by "hardcover export" into your Hardcover
+library, for example after moving to a new account.
+
+Books are matched by their Hardcover ID and added with their status,
+rating, review, private notes, edition, format, ownership and every read.
+Journal notes and quotes are added with the book they belong to; other
+journal entries are recorded by Hardcover itself as the books are added.
+Books already in your library are left unchanged.
+
+Lists are restored after the books: a list is created when you have no
+list with the same slug, and books missing from it are added with their
+position and reason.
+
+Example:
+  hardcover import hardcover-archive library.json --dry-run
+  hardcover import hardcover-archive library.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var lists []client.List
+		return runImport(cmd, &importSource{
+			name: "hardcover-archive",
+			parse: func(r io.Reader) ([]importRecord, error) {
+				archive, err := readArchive(r)
+				if err != nil {
+					return nil, err
+				}
+				lists = archive.Lists
+				return archiveRecords(archive)
+			},
+			restore: func(im *importer, w io.Writer) error {
+				return im.restoreLists(lists, w)
+			},
+		}, args[0])
+	},
+}
+
+// readArchive decodes a library archive, failing when it was not written by
+// export or by a newer version of it.
+func readArchive(r io.Reader) (*libraryArchive, error) {
+	var archive libraryArchive
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("not a library archive: %w", err)
+	}
+	if archive.Schema != archiveSchema {
+		return nil, fmt.Errorf("not a library archive: unknown schema %q", archive.Schema)
+	}
+	if archive.Version < 1 || archive.Version > archiveVersion {
+		return nil, fmt.Errorf("unsupported archive version %d; this version of hardcover reads version %d",
+			archive.Version, archiveVersion)
+	}
+	return &archive, nil
+}
+
+// archiveRecords converts the archive's shelf entries to rows, numbered
+// from 1, each with the notes and quotes of its book.
+func archiveRecords(archive *libraryArchive) ([]importRecord, error) {
+	journals := make(map[int][]client.ReadingJournalInput)
+	for i := range archive.Journals {
+		journal := &archive.Journals[i]
+		if journal.Event != client.JournalEventNote && journal.Event != client.JournalEventQuote {
+			continue
+		}
+		input := client.ReadingJournalInput{
+			EditionID:        journal.EditionID,
+			Event:            journal.Event,
+			Entry:            &journal.Entry,
+			PrivacySettingID: journal.PrivacySettingID,
+		}
+		if len(journal.Metadata) > 0 {
+			metadata, err := json.Marshal(journal.Metadata)
+			if err != nil {
+				return nil, fmt.Errorf("journal entry %d: %w", journal.ID, err)
+			}
+			input.Metadata = metadata
+		}
+		journals[journal.BookID] = append(journals[journal.BookID], input)
+	}
+
+	records := make([]importRecord, 0, len(archive.Books))
+	for i := range archive.Books {
+		entry := &archive.Books[i]
+		record := importRecord{
+			Line:              i + 1,
+			Key:               "hardcover:" + strconv.Itoa(entry.BookID),
+			BookID:            entry.BookID,
+			EditionID:         entry.EditionID,
+			Status:            entry.StatusID,
+			Rating:            entry.Rating,
+			DateAdded:         entry.DateAdded,
+			Review:            entry.ReviewRaw,
+			ReviewHasSpoilers: entry.ReviewHasSpoilers,
+			ReviewedAt:        entry.ReviewedAt,
+			PrivateNotes:      entry.PrivateNotes,
+			Privacy:           entry.PrivacySettingID,
+			Owned:             entry.Owned,
+			Format:            entry.ReadingFormatID,
+			Journals:          journals[entry.BookID],
+		}
+		if entry.Book != nil {
+			record.Title = entry.Book.Title
+			if authors := authorNames(entry.Book.Contributions); len(authors) > 0 {
+				record.Author = authors[0]
+			}
+		}
+		for _, read := range entry.Reads {
+			record.Reads = append(record.Reads, importRead{
+				EditionID:       read.EditionID,
+				StartedAt:       read.StartedAt,
+				PausedAt:        read.PausedAt,
+				FinishedAt:      read.FinishedAt,
+				ProgressPages:   read.ProgressPages,
+				ProgressSeconds: read.ProgressSeconds,
+			})
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// restoreLists restores the archive's lists, writing a line per list to w.
+func (im *importer) restoreLists(lists []client.List, w io.Writer) error {
+	if len(lists) == 0 {
+		return nil
+	}
+	userID, err := im.client.CurrentUserID(im.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current user: %w", err)
+	}
+	for i := range lists {
+		outcome, err := im.restoreList(userID, &lists[i])
+		if err != nil {
+			return fmt.Errorf("failed to restore list %q: %w", lists[i].Name, err)
+		}
+		printToStdoutf(w, "List %q: %s\n", lists[i].Name, outcome)
+	}
+	return nil
+}
+
+// restoreList creates an archived list when the user has none with its slug
+// and adds the books missing from it, returning what was done.
+func (im *importer) restoreList(userID int, archived *client.List) (string, error) {
+	key := "list:" + archived.Slug
+	if _, done := im.state.Rows[key]; done {
+		return "restored by an earlier run", nil
+	}
+
+	list, err := im.client.GetListBySlug(im.ctx, userID, archived.Slug)
+	if errors.Is(err, client.ErrNotFound) {
+		list = nil
+	} else if err != nil {
+		return "", err
+	}
+	var missing []client.ListBook
+	for _, listBook := range archived.ListBooks {
+		if list == nil || list.ListBookFor(listBook.BookID) == nil {
+			missing = append(missing, listBook)
+		}
+	}
+
+	books := pluralize(len(missing), "book", "books")
+	outcome := "added " + books
+	switch {
+	case im.dryRun && list == nil:
+		return "would be created with " + books, nil
+	case im.dryRun:
+		return books + " would be added", nil
+	case list == nil:
+		description, ranked := archived.Description, archived.Ranked
+		list, err = im.client.AddList(im.ctx, &client.ListInput{
+			Name:             archived.Name,
+			Description:      &description,
+			Ranked:           &ranked,
+			PrivacySettingID: archived.PrivacySettingID,
+		})
+		if err != nil {
+			return "", err
+		}
+		outcome = "created with " + books
+	}
+
+	for _, listBook := range missing {
+		input := &client.ListBookInput{ListID: list.ID, BookID: listBook.BookID, EditionID: listBook.EditionID}
+		if archived.Ranked {
+			input.Position = listBook.Position
+		}
+		if listBook.Reason != "" {
+			reason := listBook.Reason
+			input.Reason = &reason
+		}
+		if _, err := im.client.AddListBook(im.ctx, input); err != nil {
+			return "", err
+		}
+	}
+	return outcome, im.state.record(key, importStateRow{Action: importActionImported})
+}
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/isbn"
+)
+
+// goodreadsDateLayout is the date format of Goodreads exports.
+const goodreadsDateLayout = "2006/01/02"
+
+// goodreadsShelves maps Goodreads' built-in exclusive shelves to statuses.
+var goodreadsShelves = map[string]client.UserBookStatus{
+	"to-read":           client.StatusWantToRead,
+	"currently-reading": client.StatusCurrentlyReading,
+	"read":              client.StatusRead,
+}
+
+// importGoodreadsCmd represents the import goodreads command.
+var importGoodreadsCmd = &cobra.Command{
+	Use:   "goodreads <file.csv>",
+	Short: "Import a Goodreads library export",
+	Long: `Import the CSV file from Goodreads' "Import and export" page
+(https://www.goodreads.com/review/import) into your Hardcover library.
+
+Each row is matched by its ISBN13 or ISBN, then by title and author. The
+exclusive shelf sets the status: to-read, currently-reading and read map
+to Want to Read, Currently Reading and Read, and custom shelves such as
+"dnf" or "paused" map to the status of the same name. Star ratings,
+reviews, the date added and the date read are kept, and books with owned
+copies or on an "owned" shelf are marked as owned.
+
+Example:
+  hardcover import goodreads goodreads_library_export.csv --dry-run
+  hardcover import goodreads goodreads_library_export.csv
+  hardcover import goodreads export.csv --dry-run -o csv > matches.csv`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runImport(cmd, &importSource{name: "goodreads", parse: parseGoodreadsExport}, args[0])
+	},
+}
+
+// parseGoodreadsExport reads the rows of a Goodreads library export.
+func parseGoodreadsExport(r io.Reader) ([]importRecord, error) {
+	data, err := readImportCSV(r, "Title", "Author", "Exclusive Shelf")
+	if err != nil {
+		return nil, fmt.Errorf("not a Goodreads export: %w", err)
+	}
+
+	records := make([]importRecord, 0, len(data.rows))
+	for i, row := range data.rows {
+		record, err := parseGoodreadsRow(data, row)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", data.lines[i], err)
+		}
+		record.Line = data.lines[i]
+		records = append(records, *record)
+	}
+	return records, nil
+}
+
+// parseGoodreadsRow converts a row of a Goodreads export.
+func parseGoodreadsRow(data *importCSV, row []string) (*importRecord, error) {
+	shelves := client.SplitList(data.value(row, "Bookshelves"))
+	ownedCopies, _ := strconv.Atoi(data.value(row, "Owned Copies"))
+	record := &importRecord{
+		Title:  data.value(row, "Title"),
+		Author: data.value(row, "Author"),
+		ISBNs:  goodreadsISBNs(data.value(row, "ISBN13"), data.value(row, "ISBN")),
+		Status: goodreadsStatus(data.value(row, "Exclusive Shelf"), shelves),
+		Review: goodreadsReview(data.value(row, "My Review")),
+		Owned:  ownedCopies > 0 || containsName(shelves, "owned"),
+	}
+	record.Key = "goodreads:" + data.value(row, "Book Id")
+	if record.Key == "goodreads:" {
+		record.Key += normalizeImportName(record.Title) + "|" + normalizeImportName(record.Author)
+	}
+
+	if rating := data.value(row, "My Rating"); rating != "" {
+		stars, err := strconv.Atoi(rating)
+		if err != nil || stars < 0 || stars > maxRating {
+			return nil, fmt.Errorf("invalid rating %q", rating)
+		}
+		record.Rating = float64(stars)
+	}
+
+	var err error
+	if record.DateAdded, err = parseImportDate(data.value(row, "Date Added"), goodreadsDateLayout); err != nil {
+		return nil, err
+	}
+	finished, err := parseImportDate(data.value(row, "Date Read"), goodreadsDateLayout)
+	if err != nil {
+		return nil, err
+	}
+	if finished != "" {
+		record.Reads = []importRead{{FinishedAt: finished}}
+	}
+	return record, nil
+}
+
+// goodreadsISBNs parses the ISBN columns of a row, which Goodreads writes as
+// spreadsheet formulas such as ="0441013597". Missing and invalid ISBNs are
+// skipped.
+func goodreadsISBNs(values ...string) []isbn.ISBN {
+	var numbers []isbn.ISBN
+	for _, value := range values {
+		number, err := isbn.Parse(strings.Trim(value, `="`))
+		if err != nil {
+			continue
+		}
+		if len(numbers) == 0 || numbers[0].ISBN13 != number.ISBN13 {
+			numbers = append(numbers, number)
+		}
+	}
+	return numbers
+}
+
+// goodreadsStatus maps a row's exclusive shelf to a status. Custom shelves
+// are matched by status name, skipping names that are only digits, then the
+// row's other shelves are tried. Rows that match nothing are shelved as Want
+// to Read.
+func goodreadsStatus(exclusive string, shelves []string) client.UserBookStatus {
+	if status, ok := goodreadsShelves[exclusive]; ok {
+		return status
+	}
+	for _, shelf := range append([]string{exclusive}, shelves...) {
+		if _, err := strconv.Atoi(shelf); err == nil {
+			continue
+		}
+		if status, err := client.ParseUserBookStatus(shelf); err == nil {
+			return status
+		}
+	}
+	return client.StatusWantToRead
+}
+
+// goodreadsReview converts the line breaks Goodreads stores in reviews as
+// HTML.
+func goodreadsReview(review string) string {
+	return strings.NewReplacer("<br/>", "\n", "<br />", "\n", "<br>", "\n").Replace(review)
+}
+
+// containsName reports whether names includes name, ignoring case.
+func containsName(names []string, name string) bool {
+	for _, candidate := range names {
+		if strings.EqualFold(candidate, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/isbn"
+)
+
+// storygraphDateLayout is the date format of StoryGraph exports.
+const storygraphDateLayout = "2006/01/02"
+
+// storygraphFormats maps StoryGraph's formats to reading formats.
+var storygraphFormats = map[string]client.ReadingFormat{
+	"paperback": client.ReadingFormatPhysical,
+	"hardcover": client.ReadingFormatPhysical,
+	"physical":  client.ReadingFormatPhysical,
+	"digital":   client.ReadingFormatEbook,
+	"ebook":     client.ReadingFormatEbook,
+	"audio":     client.ReadingFormatAudiobook,
+	"audiobook": client.ReadingFormatAudiobook,
+}
+
+// importStorygraphCmd represents the import storygraph command.
+var importStorygraphCmd = &cobra.Command{
+	Use:   "storygraph <file.csv>",
+	Short: "Import a StoryGraph export",
+	Long: `Import the CSV file from StoryGraph's "Manage Account" > "Export StoryGraph
+Library" page into your Hardcover library.
+
+Each row is matched by its ISBN, then by title and first author. The read
+status sets the status; star ratings are rounded to the nearest half star;
+every range in Dates Read becomes a separate read; and the format and
+owned columns set the reading format and whether you own the book. Moods
+are shown in the report but not imported, as Hardcover has no moods of
+your own to keep them in.
+
+Example:
+  hardcover import storygraph storygraph_export.csv --dry-run
+  hardcover import storygraph storygraph_export.csv
+  hardcover import storygraph export.csv --dry-run -o csv > matches.csv`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runImport(cmd, &importSource{name: "storygraph", parse: parseStorygraphExport}, args[0])
+	},
+}
+
+// parseStorygraphExport reads the rows of a StoryGraph export.
+func parseStorygraphExport(r io.Reader) ([]importRecord, error) {
+	data, err := readImportCSV(r, "Title", "Authors", "Read Status")
+	if err != nil {
+		return nil, fmt.Errorf("not a StoryGraph export: %w", err)
+	}
+
+	records := make([]importRecord, 0, len(data.rows))
+	for i, row := range data.rows {
+		record, err := parseStorygraphRow(data, row)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", data.lines[i], err)
+		}
+		record.Line = data.lines[i]
+		records = append(records, *record)
+	}
+	return records, nil
+}
+
+// parseStorygraphRow converts a row of a StoryGraph export.
+func parseStorygraphRow(data *importCSV, row []string) (*importRecord, error) {
+	record := &importRecord{
+		Title:  data.value(row, "Title"),
+		Review: data.value(row, "Review"),
+		Owned:  strings.EqualFold(data.value(row, "Owned?"), "yes"),
+		Format: storygraphFormats[strings.ToLower(data.value(row, "Format"))],
+		Moods:  client.SplitList(data.value(row, "Moods")),
+	}
+	if authors := client.SplitList(data.value(row, "Authors")); len(authors) > 0 {
+		record.Author = authors[0]
+	}
+	id := data.value(row, "ISBN/UID")
+	if number, err := isbn.Parse(id); err == nil {
+		record.ISBNs = []isbn.ISBN{number}
+	}
+	record.Key = "storygraph:" + id
+	if id == "" {
+		record.Key += normalizeImportName(record.Title) + "|" + normalizeImportName(record.Author)
+	}
+
+	var err error
+	if record.Status, err = storygraphStatus(data.value(row, "Read Status")); err != nil {
+		return nil, err
+	}
+	if record.Rating, err = storygraphRating(data.value(row, "Star Rating")); err != nil {
+		return nil, err
+	}
+	if record.DateAdded, err = parseImportDate(data.value(row, "Date Added"), storygraphDateLayout); err != nil {
+		return nil, err
+	}
+	if record.Reads, err = storygraphReads(data.value(row, "Dates Read"), data.value(row, "Last Date Read")); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// storygraphStatus maps a read status such as "to-read" or
+// "did-not-finish" to a status. Rows without one are Want to Read.
+func storygraphStatus(value string) (client.UserBookStatus, error) {
+	if value == "" || value == "to-read" {
+		return client.StatusWantToRead, nil
+	}
+	status, err := client.ParseUserBookStatus(value)
+	if err != nil {
+		return 0, fmt.Errorf("unknown read status %q", value)
+	}
+	return status, nil
+}
+
+// storygraphRating converts a rating in quarter stars to the nearest half
+// star. Empty and zero ratings mean no rating.
+func storygraphRating(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	rating, err := strconv.ParseFloat(value, 64)
+	if err != nil || rating < 0 || rating > maxRating {
+		return 0, fmt.Errorf("invalid rating %q", value)
+	}
+	return math.Round(rating/ratingStep) * ratingStep, nil
+}
+
+// storygraphReads parses the Dates Read column: comma-separated reads, each
+// a finish date or a start-finish range whose ends may be missing. Rows
+// without it fall back to a single read finished on the Last Date Read.
+func storygraphReads(datesRead, lastRead string) ([]importRead, error) {
+	if datesRead == "" {
+		datesRead = lastRead
+	}
+
+	var reads []importRead
+	for _, value := range client.SplitList(datesRead) {
+		start, finish, isRange := strings.Cut(value, "-")
+		if !isRange {
+			start, finish = "", value
+		}
+		var read importRead
+		var err error
+		if read.StartedAt, err = parseImportDate(strings.TrimSpace(start), storygraphDateLayout); err != nil {
+			return nil, err
+		}
+		if read.FinishedAt, err = parseImportDate(strings.TrimSpace(finish), storygraphDateLayout); err != nil {
+			return nil, err
+		}
+		if read != (importRead{}) {
+			reads = append(reads, read)
+		}
+	}
+	return reads, nil
+}
@@ -0,0 +1,284 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/client"
+)
+
+// goodreadsExport is a Goodreads export with a row matched by ISBN, one
+// matched by search and one that matches nothing.
+const goodreadsExport = "\ufeffBook Id,Title,Author,ISBN,ISBN13,My Rating,Date Read,Date Added,Bookshelves,Exclusive Shelf,My Review,Owned Copies\n" +
+	`234225,Dune,Frank Herbert,"=""0441013597""","=""9780441013593""",5,2024/03/01,2024/01/15,"owned, favorites",read,"Spice.<br/>Worms.",0` + "\n" +
+	`77566,"Hyperion (Hyperion Cantos, #1)",Dan Simmons,"=""""","=""""",0,,2024/02/10,,currently-reading,,0` + "\n" +
+	`999,The Unwritten Book,Nobody,"=""""","=""""",0,,2024/02/11,dnf,dnf,,1` + "\n"
+
+// writeImportFile writes an export to a temporary directory, returning its
+// path.
+func writeImportFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestParseGoodreadsExport(t *testing.T) {
+	records, err := readImportFile(writeImportFile(t, "export.csv", goodreadsExport), parseGoodreadsExport)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	dune := records[0]
+	assert.Equal(t, 2, dune.Line)
+	assert.Equal(t, "goodreads:234225", dune.Key)
+	require.Len(t, dune.ISBNs, 1)
+	assert.Equal(t, "9780441013593", dune.ISBNs[0].ISBN13)
+	assert.Equal(t, client.StatusRead, dune.Status)
+	assert.InDelta(t, 5.0, dune.Rating, 0)
+	assert.Equal(t, "2024-01-15", dune.DateAdded)
+	assert.Equal(t, []importRead{{FinishedAt: "2024-03-01"}}, dune.Reads)
+	assert.Equal(t, "Spice.\nWorms.", dune.Review)
+	assert.True(t, dune.Owned)
+
+	hyperion := records[1]
+	assert.Equal(t, "Hyperion (Hyperion Cantos, #1)", hyperion.Title)
+	assert.Empty(t, hyperion.ISBNs)
+	assert.Equal(t, client.StatusCurrentlyReading, hyperion.Status)
+	assert.Zero(t, hyperion.Rating)
+	assert.Empty(t, hyperion.Reads)
+	assert.False(t, hyperion.Owned)
+
+	assert.Equal(t, client.StatusDidNotFinish, records[2].Status)
+	assert.True(t, records[2].Owned)
+}
+
+func TestParseGoodreadsExport_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "empty", content: "", wantErr: "not a Goodreads export: the file is empty"},
+		{name: "columns", content: "Title,Author\nDune,Frank Herbert\n", wantErr: `missing column "Exclusive Shelf"`},
+		{name: "rating", content: "Title,Author,Exclusive Shelf,My Rating\nDune,Frank Herbert,read,9\n", wantErr: `line 2: invalid rating "9"`},
+		{name: "date", content: "Title,Author,Exclusive Shelf,Date Read\nDune,Frank Herbert,read,March\n", wantErr: `line 2: invalid date "March"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readImportFile(writeImportFile(t, "export.csv", tt.content), parseGoodreadsExport)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestSameImportName(t *testing.T) {
+	assert.True(t, sameImportName("Hyperion", "Hyperion (Hyperion Cantos, #1)"))
+	assert.True(t, sameImportName("Dune: Deluxe Edition", "dune"))
+	assert.True(t, sameImportName("J.R.R. Tolkien", "J. R. R.  Tolkien"))
+	assert.False(t, sameImportName("Dune Messiah", "Dune"))
+}
+
+func TestImportGoodreadsCmd_DryRun(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	path := writeImportFile(t, "export.csv", goodreadsExport)
+	cmd, output := newFlagTestCommand(t, url, "text", addImportFlags, "--dry-run")
+
+	require.NoError(t, importGoodreadsCmd.RunE(cmd, []string{path}))
+
+	assert.Equal(t, "Line 2: Dune by Frank Herbert: matched by ISBN to \"Dune\" (book 328491, edition 31), would import\n"+
+		"Line 3: Hyperion (Hyperion Cantos, #1) by Dan Simmons: matched by search to \"Hyperion\" (book 2), would import\n"+
+		"Line 4: The Unwritten Book by Nobody: no match found\n"+
+		"\nDry run: 2 of 3 rows would be imported. Nothing was changed.\n   Not found on Hardcover: 1\n", output.String())
+	assert.Empty(t, library.mutations)
+	assert.NoFileExists(t, path+".hardcover-import.json")
+}
+
+func TestImportGoodreadsCmd_ImportsAndResumes(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	path := writeImportFile(t, "export.csv", goodreadsExport)
+	cmd, output := newFlagTestCommand(t, url, "text", addImportFlags)
+
+	require.NoError(t, importGoodreadsCmd.RunE(cmd, []string{path}))
+
+	assert.Contains(t, output.String(), "Line 2: Dune by Frank Herbert: matched by ISBN to \"Dune\" (book 328491, edition 31), imported\n")
+	assert.Contains(t, output.String(), "\nImported 2 of 3 rows.\n   Not found on Hardcover: 1\n")
+	require.Equal(t, []string{"InsertUserBook", "InsertUserBookRead", "InsertUserBook"}, library.operations())
+	assert.Equal(t, map[string]interface{}{
+		"book_id": float64(328491), "status_id": float64(3), "edition_id": float64(31), "rating": float64(5),
+		"review_raw": "Spice.\nWorms.", "date_added": "2024-01-15", "owned": true,
+	}, library.mutations[0].Variables["object"])
+	assert.Equal(t, map[string]interface{}{
+		"edition_id": float64(31), "started_at": nil, "paused_at": nil, "finished_at": "2024-03-01",
+		"progress_pages": nil, "progress_seconds": nil,
+	}, library.mutations[1].Variables["user_book_read"])
+	assert.Equal(t, map[string]interface{}{
+		"book_id": float64(2), "status_id": float64(2), "date_added": "2024-02-10",
+	}, library.mutations[2].Variables["object"])
+
+	data, err := os.ReadFile(path + ".hardcover-import.json")
+	require.NoError(t, err)
+	var state importState
+	require.NoError(t, json.Unmarshal(data, &state))
+	assert.Equal(t, "goodreads", state.Source)
+	assert.Equal(t, importStateRow{Action: importActionImported, BookID: 328491, UserBookID: 501}, state.Rows["goodreads:234225"])
+	assert.Len(t, state.Rows, 2)
+
+	output.Reset()
+	require.NoError(t, importGoodreadsCmd.RunE(cmd, []string{path}))
+	assert.Contains(t, output.String(), "Line 2: Dune by Frank Herbert: already imported\n")
+	assert.Contains(t, output.String(), "\nImported 0 of 3 rows.\n   Imported by an earlier run: 2\n   Not found on Hardcover: 1\n")
+	assert.Len(t, library.mutations, 3)
+}
+
+func TestImportGoodreadsCmd_ResumesFailedReads(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.errors = map[string][]interface{}{"InsertUserBookRead": {map[string]interface{}{"message": "database unavailable"}}}
+	path := writeImportFile(t, "export.csv", goodreadsExport)
+	cmd, output := newFlagTestCommand(t, url, "text", addImportFlags)
+
+	err := importGoodreadsCmd.RunE(cmd, []string{path})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 of 3 rows failed to import")
+	assert.Contains(t, output.String(), "Line 2: Dune by Frank Herbert: failed: added the book but failed to record its reads")
+	require.Equal(t, []string{"InsertUserBook", "InsertUserBookRead", "InsertUserBook"}, library.operations())
+
+	data, err := os.ReadFile(path + ".hardcover-import.json")
+	require.NoError(t, err)
+	var state importState
+	require.NoError(t, json.Unmarshal(data, &state))
+	edition := 31
+	assert.Equal(t, importStateRow{Action: importStatePartial, BookID: 328491, UserBookID: 501, EditionID: &edition},
+		state.Rows["goodreads:234225"])
+
+	library.errors = nil
+	output.Reset()
+	require.NoError(t, importGoodreadsCmd.RunE(cmd, []string{path}))
+	assert.Contains(t, output.String(), "Line 2: Dune by Frank Herbert: finished the import of book 328491 begun by an earlier run\n")
+	assert.Contains(t, output.String(), "   Finished from an earlier run: 1\n")
+	require.Equal(t, []string{"InsertUserBook", "InsertUserBookRead", "InsertUserBook", "InsertUserBookRead"},
+		library.operations())
+	assert.Equal(t, float64(501), library.mutations[3].Variables["user_book_id"])
+	assert.Equal(t, float64(31), library.mutations[3].Variables["user_book_read"].(map[string]interface{})["edition_id"])
+
+	data, err = os.ReadFile(path + ".hardcover-import.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &state))
+	assert.Equal(t, importStateRow{Action: importActionResumed, BookID: 328491, UserBookID: 501}, state.Rows["goodreads:234225"])
+}
+
+func TestImportGoodreadsCmd_SkipsBooksInLibrary(t *testing.T) {
+	library, url := newFakeLibrary(t, map[int]map[string]interface{}{
+		328491: {"id": 100, "book_id": 328491, "status_id": 1},
+	})
+	path := writeImportFile(t, "export.csv", goodreadsExport)
+	cmd, output := newFlagTestCommand(t, url, "json", addImportFlags)
+	statePath := filepath.Join(t.TempDir(), "state.json")
+	require.NoError(t, cmd.Flags().Parse([]string{"--state", statePath}))
+
+	require.NoError(t, importGoodreadsCmd.RunE(cmd, []string{path}))
+
+	var rows []importRowView
+	require.NoError(t, json.Unmarshal(output.Bytes(), &rows))
+	require.Len(t, rows, 3)
+	assert.Equal(t, importActionInLibrary, rows[0].Action)
+	assert.Equal(t, 100, rows[0].UserBookID)
+	assert.Equal(t, importActionImported, rows[1].Action)
+	assert.Equal(t, importMatchSearch, rows[1].Match)
+	assert.Equal(t, importActionUnmatched, rows[2].Action)
+	assert.Equal(t, []string{"InsertUserBook"}, library.operations())
+	assert.FileExists(t, statePath)
+}
+
+func TestImportGoodreadsCmd_StateFromAnotherSource(t *testing.T) {
+	_, url := newFakeLibrary(t, nil)
+	path := writeImportFile(t, "export.csv", goodreadsExport)
+	require.NoError(t, os.WriteFile(path+".hardcover-import.json", []byte(`{"source": "storygraph", "rows": {}}`), 0o600))
+	cmd, _ := newFlagTestCommand(t, url, "text", addImportFlags)
+
+	err := importGoodreadsCmd.RunE(cmd, []string{path})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "belongs to a storygraph import")
+}
+
+// storygraphExport is a StoryGraph export with a twice-read book matched by
+// ISBN and a book matched by search.
+const storygraphExport = "Title,Authors,Contributors,ISBN/UID,Format,Read Status,Date Added,Last Date Read,Dates Read,Read Count,Moods,Star Rating,Review,Owned?\n" +
+	`Dune,"Frank Herbert, Brian Herbert",,9780441013593,audio,read,2023/01/02,2024/05/20,"2023/01/05-2023/02/01, 2024/05/01-2024/05/20",2,"adventurous, challenging",4.25,A classic.,Yes` + "\n" +
+	`Hyperion,Dan Simmons,,b1c2d3e4-uid,paperback,currently-reading,2024/06/01,,2024/06/02-,0,,,,No` + "\n"
+
+func TestParseStorygraphExport(t *testing.T) {
+	records, err := readImportFile(writeImportFile(t, "export.csv", storygraphExport), parseStorygraphExport)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	dune := records[0]
+	assert.Equal(t, "storygraph:9780441013593", dune.Key)
+	assert.Equal(t, "Frank Herbert", dune.Author)
+	require.Len(t, dune.ISBNs, 1)
+	assert.Equal(t, client.StatusRead, dune.Status)
+	assert.InDelta(t, 4.5, dune.Rating, 0)
+	assert.Equal(t, client.ReadingFormatAudiobook, dune.Format)
+	assert.True(t, dune.Owned)
+	assert.Equal(t, []string{"adventurous", "challenging"}, dune.Moods)
+	assert.Equal(t, []importRead{
+		{StartedAt: "2023-01-05", FinishedAt: "2023-02-01"},
+		{StartedAt: "2024-05-01", FinishedAt: "2024-05-20"},
+	}, dune.Reads)
+
+	hyperion := records[1]
+	assert.Equal(t, "storygraph:b1c2d3e4-uid", hyperion.Key)
+	assert.Empty(t, hyperion.ISBNs)
+	assert.Equal(t, client.StatusCurrentlyReading, hyperion.Status)
+	assert.Equal(t, client.ReadingFormatPhysical, hyperion.Format)
+	assert.Equal(t, []importRead{{StartedAt: "2024-06-02"}}, hyperion.Reads)
+	assert.False(t, hyperion.Owned)
+}
+
+func TestStorygraphReadsAndRating(t *testing.T) {
+	reads, err := storygraphReads("", "2024/05/20")
+	require.NoError(t, err)
+	assert.Equal(t, []importRead{{FinishedAt: "2024-05-20"}}, reads)
+
+	_, err = storygraphReads("2024/05/01-May", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid date "May"`)
+
+	for value, want := range map[string]float64{"": 0, "0.25": 0.5, "3.75": 4, "5.0": 5} {
+		rating, ratingErr := storygraphRating(value)
+		require.NoError(t, ratingErr)
+		assert.InDelta(t, want, rating, 0, value)
+	}
+	_, err = storygraphRating("6")
+	require.Error(t, err)
+
+	_, err = storygraphStatus("lost")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown read status "lost"`)
+}
+
+func TestImportStorygraphCmd(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	path := writeImportFile(t, "export.csv", storygraphExport)
+	cmd, output := newFlagTestCommand(t, url, "text", addImportFlags)
+
+	require.NoError(t, importStorygraphCmd.RunE(cmd, []string{path}))
+
+	assert.Contains(t, output.String(), "\nImported 2 of 2 rows.\n")
+	require.Equal(t, []string{"InsertUserBook", "InsertUserBookRead", "InsertUserBookRead", "InsertUserBook", "InsertUserBookRead"},
+		library.operations())
+	assert.Equal(t, map[string]interface{}{
+		"book_id": float64(328491), "status_id": float64(3), "edition_id": float64(31), "rating": 4.5,
+		"review_raw": "A classic.", "date_added": "2023-01-02", "owned": true, "reading_format_id": float64(2),
+	}, library.mutations[0].Variables["object"])
+	assert.Equal(t, "2023-01-05", library.mutations[1].Variables["user_book_read"].(map[string]interface{})["started_at"])
+	assert.Equal(t, "2024-05-20", library.mutations[2].Variables["user_book_read"].(map[string]interface{})["finished_at"])
+	assert.Equal(t, float64(1), library.mutations[3].Variables["object"].(map[string]interface{})["reading_format_id"])
+	assert.Equal(t, "2024-06-02", library.mutations[4].Variables["user_book_read"].(map[string]interface{})["started_at"])
+}
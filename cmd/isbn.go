@@ -0,0 +1,306 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/isbn"
+)
+
+// Lookup statuses reported for each ISBN.
+const (
+	isbnStatusFound    = "found"
+	isbnStatusNotFound = "not_found"
+	isbnStatusInvalid  = "invalid"
+)
+
+// isbnCmd represents the isbn command.
+var isbnCmd = &cobra.Command{
+	Use:   "isbn [isbn...]",
+	Short: "Look up editions and books by ISBN",
+	Long: `Look up editions by ISBN-10 or ISBN-13 and show each edition with the
+book it belongs to.
+
+ISBNs may contain hyphens or spaces. Each ISBN is validated, including its
+check digit, and converted between ISBN-10 and ISBN-13 so that editions
+recorded under either form are found.
+
+ISBNs are read from the arguments, from a file given with --file, or from
+standard input when neither is given, one per line. Arguments and --file
+cannot be combined. Blank lines and lines
+starting with # are ignored.
+
+Example:
+  hardcover isbn 9780441013593
+  hardcover isbn 0-441-01359-7 978-0-7653-2635-5
+  hardcover isbn --file scanned.txt -o csv
+  cat scanned.txt | hardcover isbn`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		inputs, err := isbnInputs(cmd, args)
+		if err != nil {
+			return err
+		}
+		if len(inputs) == 0 {
+			return errors.New("no ISBNs given")
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		results, err := lookupISBNs(context.Background(), gqlClient, inputs)
+		if err != nil {
+			return fmt.Errorf("failed to look up ISBNs: %w", err)
+		}
+
+		if renderErr := render(cmd, results, func(w io.Writer) {
+			printISBNResults(w, results)
+		}); renderErr != nil {
+			return renderErr
+		}
+
+		for _, result := range results {
+			if result.Status == isbnStatusFound {
+				return nil
+			}
+		}
+		return errors.New("no editions found for the given ISBNs")
+	},
+}
+
+// isbnInputs collects the ISBNs to look up from the arguments, the --file
+// flag or standard input. Arguments and --file cannot be combined.
+func isbnInputs(cmd *cobra.Command, args []string) ([]string, error) {
+	path := ""
+	if cmd.Flags().Lookup("file") != nil {
+		var err error
+		if path, err = cmd.Flags().GetString("file"); err != nil {
+			return nil, err
+		}
+	}
+	if len(args) > 0 {
+		if path != "" {
+			return nil, errors.New("give ISBNs either as arguments or with --file, not both")
+		}
+		return args, nil
+	}
+	if path == "" || path == "-" {
+		return readISBNLines(cmd.InOrStdin())
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ISBN file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	return readISBNLines(file)
+}
+
+// readISBNLines reads one ISBN per line, skipping blank lines and comments.
+func readISBNLines(r io.Reader) ([]string, error) {
+	var inputs []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		inputs = append(inputs, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ISBNs: %w", err)
+	}
+	return inputs, nil
+}
+
+// isbnEdition is the matching edition in the isbn command's output.
+type isbnEdition struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	Format      string `json:"format"`
+	Publisher   string `json:"publisher"`
+	Language    string `json:"language"`
+	Pages       int    `json:"pages"`
+	ReleaseDate string `json:"release_date"`
+}
+
+// isbnBook is the parent book in the isbn command's output.
+type isbnBook struct {
+	ID          int      `json:"id"`
+	Title       string   `json:"title"`
+	Authors     []string `json:"authors"`
+	ReleaseYear int      `json:"release_year"`
+	URL         string   `json:"url"`
+}
+
+// isbnResult is the outcome of looking up one ISBN.
+type isbnResult struct {
+	Input   string       `json:"input"`
+	ISBN10  string       `json:"isbn_10"`
+	ISBN13  string       `json:"isbn_13"`
+	Status  string       `json:"status"`
+	Error   string       `json:"error"`
+	Edition *isbnEdition `json:"edition"`
+	Book    *isbnBook    `json:"book"`
+}
+
+// lookupISBNs validates every input and resolves the valid ones with a
+// single batched editions query.
+func lookupISBNs(ctx context.Context, c *client.Client, inputs []string) ([]isbnResult, error) {
+	results := make([]isbnResult, len(inputs))
+	numbers := make([]isbn.ISBN, len(inputs))
+	var valid []isbn.ISBN
+	for i, input := range inputs {
+		results[i] = isbnResult{Input: input, Status: isbnStatusInvalid}
+		number, err := isbn.Parse(input)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		numbers[i] = number
+		results[i].ISBN10, results[i].ISBN13 = number.ISBN10, number.ISBN13
+		results[i].Status = isbnStatusNotFound
+		valid = append(valid, number)
+	}
+	if len(valid) == 0 {
+		return results, nil
+	}
+
+	editions, err := c.GetEditionsByISBN(ctx, valid)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		if results[i].Status == isbnStatusInvalid {
+			continue
+		}
+		for j := range editions {
+			if editions[j].Matches(numbers[i]) {
+				results[i].Status = isbnStatusFound
+				results[i].Edition, results[i].Book = newISBNEdition(&editions[j])
+				break
+			}
+		}
+	}
+	return results, nil
+}
+
+// newISBNEdition converts a client edition into its output representation.
+func newISBNEdition(edition *client.EditionDetail) (*isbnEdition, *isbnBook) {
+	view := &isbnEdition{
+		ID:          edition.ID,
+		Title:       edition.Title,
+		Format:      edition.Format(),
+		Pages:       edition.Pages,
+		ReleaseDate: edition.ReleaseDate,
+	}
+	if edition.Publisher != nil {
+		view.Publisher = edition.Publisher.Name
+	}
+	if edition.Language != nil {
+		view.Language = edition.Language.Language
+	}
+	if edition.Book == nil {
+		return view, nil
+	}
+
+	book := &isbnBook{
+		ID:          edition.Book.ID,
+		Title:       edition.Book.Title,
+		Authors:     authorNames(edition.Book.Contributions),
+		ReleaseYear: edition.Book.ReleaseYear,
+	}
+	if edition.Book.Slug != "" {
+		book.URL = "https://hardcover.app/books/" + edition.Book.Slug
+	}
+	return view, book
+}
+
+// authorNames returns the names of the primary authors among contributions,
+// falling back to every contributor when none is marked as primary.
+func authorNames(contributions []client.BookContribution) []string {
+	var authors, everyone []string
+	for _, contribution := range contributions {
+		if contribution.Author == nil {
+			continue
+		}
+		everyone = append(everyone, contribution.Author.Name)
+		if contribution.Contribution == "" {
+			authors = append(authors, contribution.Author.Name)
+		}
+	}
+	if len(authors) == 0 {
+		return everyone
+	}
+	return authors
+}
+
+// printISBNResults writes the lookup results as human-readable text.
+func printISBNResults(w io.Writer, results []isbnResult) {
+	for i := range results {
+		result := &results[i]
+		switch result.Status {
+		case isbnStatusInvalid:
+			printToStdoutf(w, "%s: invalid ISBN: %s\n", result.Input, result.Error)
+		case isbnStatusNotFound:
+			printToStdoutf(w, "%s: no edition found (ISBN-13 %s)\n", result.Input, result.ISBN13)
+		default:
+			printISBNResult(w, result)
+		}
+		printSearchSeparator(w)
+	}
+}
+
+// printISBNResult writes one resolved ISBN.
+func printISBNResult(w io.Writer, result *isbnResult) {
+	printToStdoutf(w, "%s\n", result.Input)
+	printToStdoutf(w, "   ISBN-13: %s\n", result.ISBN13)
+	if result.ISBN10 != "" {
+		printToStdoutf(w, "   ISBN-10: %s\n", result.ISBN10)
+	}
+
+	edition := result.Edition
+	printToStdoutf(w, "   Edition: %s\n", edition.Title)
+	details := []string{}
+	for _, detail := range []string{edition.Format, edition.Publisher, edition.Language, edition.ReleaseDate} {
+		if detail != "" {
+			details = append(details, detail)
+		}
+	}
+	if edition.Pages > 0 {
+		details = append(details, fmt.Sprintf("%d pages", edition.Pages))
+	}
+	if len(details) > 0 {
+		printToStdoutf(w, "   Details: %s\n", strings.Join(details, ", "))
+	}
+	printToStdoutf(w, "   Edition ID: %d\n", edition.ID)
+
+	if book := result.Book; book != nil {
+		printToStdoutf(w, "   Book: %s\n", book.Title)
+		if len(book.Authors) > 0 {
+			printToStdoutf(w, "   Authors: %s\n", strings.Join(book.Authors, ", "))
+		}
+		printToStdoutf(w, "   Book ID: %d\n", book.ID)
+		if book.URL != "" {
+			printToStdoutf(w, "   URL: %s\n", book.URL)
+		}
+	}
+}
+
+// setupISBNCommands registers the isbn command with the root command.
+func setupISBNCommands() {
+	isbnCmd.Flags().StringP("file", "f", "", "read ISBNs from a file, one per line (- for stdin)")
+	rootCmd.AddCommand(isbnCmd)
+}
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/testutil"
+)
+
+// testEditions is the editions response used by the isbn command tests.
+var testEditions = map[string]interface{}{
+	"editions": []interface{}{
+		map[string]interface{}{
+			"id":             31,
+			"title":          "Dune",
+			"isbn_10":        "0441013597",
+			"isbn_13":        "9780441013593",
+			"edition_format": "Mass Market Paperback",
+			"pages":          896,
+			"release_date":   "2005-08-02",
+			"publisher":      map[string]interface{}{"id": 4, "name": "Ace"},
+			"language":       map[string]interface{}{"language": "English"},
+			"book": map[string]interface{}{
+				"id":    328491,
+				"title": "Dune",
+				"slug":  "dune",
+				"contributions": []interface{}{
+					map[string]interface{}{"contribution": nil, "author": map[string]interface{}{"name": "Frank Herbert"}},
+				},
+			},
+		},
+	},
+}
+
+func TestISBNCmd_MixedResults(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(testEditions))
+	defer server.Close()
+
+	cmd, output := newBookTestCommand(server.URL, "text")
+	err := isbnCmd.RunE(cmd, []string{"0-441-01359-7", "9780765326355", "12345"})
+	require.NoError(t, err)
+
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "0-441-01359-7\n   ISBN-13: 9780441013593\n   ISBN-10: 0441013597\n")
+	assert.Contains(t, outputStr, "Edition: Dune")
+	assert.Contains(t, outputStr, "Details: Mass Market Paperback, Ace, English, 2005-08-02, 896 pages")
+	assert.Contains(t, outputStr, "Authors: Frank Herbert")
+	assert.Contains(t, outputStr, "URL: https://hardcover.app/books/dune")
+	assert.Contains(t, outputStr, "9780765326355: no edition found (ISBN-13 9780765326355)")
+	assert.Contains(t, outputStr, "12345: invalid ISBN:")
+}
+
+func TestISBNCmd_CSVOutput(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(testEditions))
+	defer server.Close()
+
+	cmd, output := newBookTestCommand(server.URL, "csv")
+	require.NoError(t, isbnCmd.RunE(cmd, []string{"9780441013593"}))
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, "input,isbn_10,isbn_13,status,error,edition.id,edition.title,edition.format,"+
+		"edition.publisher,edition.language,edition.pages,edition.release_date,"+
+		"book.id,book.title,book.authors,book.release_year,book.url", lines[0])
+	assert.True(t, strings.HasPrefix(lines[1], "9780441013593,0441013597,9780441013593,found,,31,Dune,"))
+}
+
+func TestISBNCmd_ReadsStdin(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(testEditions))
+	defer server.Close()
+
+	cmd, output := newBookTestCommand(server.URL, "text")
+	cmd.SetIn(strings.NewReader("# scanned today\n\n9780441013593\n"))
+	require.NoError(t, isbnCmd.RunE(cmd, nil))
+
+	assert.Contains(t, output.String(), "Edition: Dune")
+}
+
+func TestISBNCmd_ReadsFile(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(testEditions))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "isbns.txt")
+	require.NoError(t, os.WriteFile(path, []byte("978-0-441-01359-3\n"), 0o600))
+
+	cmd, output := newBookTestCommand(server.URL, "text")
+	cmd.Flags().StringP("file", "f", "", "")
+	require.NoError(t, cmd.Flags().Set("file", path))
+	require.NoError(t, isbnCmd.RunE(cmd, nil))
+
+	assert.Contains(t, output.String(), "Book ID: 328491")
+}
+
+func TestISBNCmd_FileWithArguments(t *testing.T) {
+	cmd, _ := newBookTestCommand("http://unused.invalid", "text")
+	cmd.Flags().StringP("file", "f", "", "")
+	require.NoError(t, cmd.Flags().Set("file", "isbns.txt"))
+
+	err := isbnCmd.RunE(cmd, []string{"9780441013593"})
+	require.Error(t, err)
+	assert.Equal(t, "give ISBNs either as arguments or with --file, not both", err.Error())
+}
+
+func TestISBNCmd_NoneFound(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(map[string]interface{}{"editions": []interface{}{}}))
+	defer server.Close()
+
+	cmd, _ := newBookTestCommand(server.URL, "text")
+	err := isbnCmd.RunE(cmd, []string{"9780765326355"})
+	require.Error(t, err)
+	assert.Equal(t, "no editions found for the given ISBNs", err.Error())
+}
+
+func TestISBNCmd_NoInput(t *testing.T) {
+	cmd, _ := newBookTestCommand("http://unused.invalid", "text")
+	cmd.SetIn(strings.NewReader(""))
+	cmd.SetContext(testutil.WithTestConfigAdapter(context.Background(), testutil.SetupTestConfig(&testutil.TestConfig{})))
+
+	err := isbnCmd.RunE(cmd, nil)
+	require.Error(t, err)
+	assert.Equal(t, "no ISBNs given", err.Error())
+}
@@ -0,0 +1,575 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// timestampLength is the length of a timestamp shown to the minute,
+// e.g. "2024-03-01 12:34".
+const timestampLength = 16
+
+// journalHelp heads the frontmatter of a journal entry opened in the editor.
+const journalHelp = `# page: the page the entry refers to, or empty
+# privacy: public, followers or private
+# Write the entry below the closing ---.
+`
+
+// journalCmd represents the journal command.
+var journalCmd = &cobra.Command{
+	Use:   "journal",
+	Short: "Keep a reading journal of notes and quotes",
+	Long: `Commands for your Hardcover reading journal: notes and quotes about the
+books you read, alongside the progress and status updates Hardcover
+records for you.
+
+Books are identified by Hardcover ID, URL slug or ISBN, and journal
+entries by the ID shown by "hardcover journal list".
+
+Available subcommands:
+  add        Add a note or quote about a book
+  list       List your journal for a book, oldest first
+  edit       Edit a journal entry in your editor
+  delete     Delete a journal entry
+  summary    Count your journal entries per book`,
+}
+
+// journalAddCmd represents the journal add command.
+var journalAddCmd = &cobra.Command{
+	Use:   "add <book> [text...]",
+	Short: "Add a note or quote about a book",
+	Long: `Add a note, or a quote with --quote, to your journal for a book. Without
+text, your editor opens to write the entry.
+
+Example:
+  hardcover journal add dune "Paul's visions are unreliable narrators."
+  hardcover journal add dune --quote --page 8 "Fear is the mind-killer."
+  hardcover journal add dune --privacy private`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		document, isQuote, err := journalAddDocument(cmd, strings.Join(args[1:], " "))
+		if err != nil {
+			return err
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		book, err := resolveLibraryBook(ctx, gqlClient, args[0])
+		if err != nil {
+			return err
+		}
+		if document.Text == "" {
+			if document, err = editJournalDocument(document); err != nil {
+				return err
+			}
+		}
+
+		input, err := document.input(nil)
+		if err != nil {
+			return err
+		}
+		input.BookID, input.Event = book.ID, client.JournalEventNote
+		if isQuote {
+			input.Event = client.JournalEventQuote
+		}
+		journal, err := gqlClient.AddReadingJournal(ctx, input)
+		if err != nil {
+			return fmt.Errorf("failed to add journal entry: %w", err)
+		}
+
+		view := newJournalEntryView(&client.ReadingJournal{ID: journal.ID, BookID: book.ID, Event: input.Event}, book.Title)
+		view.Action, view.Entry, view.Page, view.Privacy = libraryActionAdded, document.Text, document.page(), document.Privacy
+		return renderJournalChange(cmd, view)
+	},
+}
+
+// journalListCmd represents the journal list command.
+var journalListCmd = &cobra.Command{
+	Use:   "list <book>",
+	Short: "List your journal for a book, oldest first",
+	Long: `List your journal entries for a book in the order you wrote them,
+including the progress and status updates Hardcover records.
+
+Example:
+  hardcover journal list dune
+  hardcover journal list dune --type quote
+  hardcover journal list dune --type note,quote -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var events string
+		if err := readStringFlags(cmd, map[string]*string{"type": &events}); err != nil {
+			return err
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		book, err := resolveLibraryBook(ctx, gqlClient, args[0])
+		if err != nil {
+			return err
+		}
+		userID, err := gqlClient.CurrentUserID(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get user profile: %w", err)
+		}
+		journals, err := gqlClient.GetReadingJournals(ctx, &client.ReadingJournalFilter{
+			UserID: userID,
+			BookID: book.ID,
+			Events: client.SplitList(events),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get journal: %w", err)
+		}
+
+		views := make([]journalEntryView, len(journals))
+		for i := range journals {
+			views[i] = *newJournalEntryView(&journals[i], book.Title)
+		}
+		return render(cmd, views, func(w io.Writer) {
+			printJournalEntryViews(w, book.Title, views)
+		})
+	},
+}
+
+// journalEditCmd represents the journal edit command.
+var journalEditCmd = &cobra.Command{
+	Use:   "edit <entry-id>",
+	Short: "Edit a journal entry in your editor",
+	Long: `Edit the text, page and privacy of one of your journal entries in your
+editor ($VISUAL, then $EDITOR, then vi). Saving the entry unchanged changes
+nothing; an invalid entry is not saved and the draft is kept.
+
+Example:
+  hardcover journal edit 1234`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		gqlClient, journal, err := findJournalEntry(ctx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		current := newJournalDocument(journal)
+		edited, err := editJournalDocument(current)
+		if err != nil {
+			return err
+		}
+		input, err := edited.input(current)
+		if err != nil {
+			return err
+		}
+
+		view := newJournalEntryView(journal, "")
+		view.Entry, view.Page, view.Privacy = edited.Text, edited.page(), edited.Privacy
+		view.Action = libraryActionUnchanged
+		if !input.IsEmpty() {
+			if _, err := gqlClient.UpdateReadingJournal(ctx, journal.ID, input); err != nil {
+				return fmt.Errorf("failed to update journal entry: %w", err)
+			}
+			view.Action = libraryActionUpdated
+		}
+		return renderJournalChange(cmd, view)
+	},
+}
+
+// journalDeleteCmd represents the journal delete command.
+var journalDeleteCmd = &cobra.Command{
+	Use:   "delete <entry-id>",
+	Short: "Delete a journal entry",
+	Long: `Delete one of your journal entries.
+
+Example:
+  hardcover journal delete 1234`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		gqlClient, journal, err := findJournalEntry(ctx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+		if err := gqlClient.DeleteReadingJournal(ctx, journal.ID); err != nil {
+			return fmt.Errorf("failed to delete journal entry: %w", err)
+		}
+
+		view := newJournalEntryView(journal, "")
+		view.Action = libraryActionRemoved
+		return renderJournalChange(cmd, view)
+	},
+}
+
+// journalSummaryCmd represents the journal summary command.
+var journalSummaryCmd = &cobra.Command{
+	Use:   "summary",
+	Short: "Count your journal entries per book",
+	Long: `Show how many journal entries you have for each book, most recently
+updated first.
+
+Example:
+  hardcover journal summary
+  hardcover journal summary -o csv`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		userID, err := gqlClient.CurrentUserID(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get user profile: %w", err)
+		}
+		summaries, err := gqlClient.GetReadingJournalsSummary(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to get journal summary: %w", err)
+		}
+
+		views := make([]journalSummaryView, len(summaries))
+		for i := range summaries {
+			views[i] = newJournalSummaryView(&summaries[i])
+		}
+		return render(cmd, views, func(w io.Writer) {
+			printJournalSummaryViews(w, views)
+		})
+	},
+}
+
+// findJournalEntry looks up one of the current user's journal entries by
+// the ID given on the command line.
+func findJournalEntry(
+	ctx context.Context, cmd *cobra.Command, identifier string,
+) (*client.Client, *client.ReadingJournal, error) {
+	id, err := strconv.Atoi(identifier)
+	if err != nil || id <= 0 {
+		return nil, nil, fmt.Errorf("invalid journal entry ID %q", identifier)
+	}
+
+	gqlClient, err := newAuthenticatedClient(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userID, err := gqlClient.CurrentUserID(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get user profile: %w", err)
+	}
+	journal, err := gqlClient.GetReadingJournal(ctx, userID, id)
+	if errors.Is(err, client.ErrNotFound) {
+		return nil, nil, fmt.Errorf("journal entry %d %w", id, client.ErrNotFound)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get journal entry: %w", err)
+	}
+	return gqlClient, journal, nil
+}
+
+// journalDocument is a journal entry as edited by the user: frontmatter
+// fields and the text below them.
+type journalDocument struct {
+	Page    *int   `yaml:"page"`
+	Privacy string `yaml:"privacy"`
+	Text    string `yaml:"-"`
+	// Metadata is the entry's stored metadata, kept when the page changes.
+	Metadata map[string]interface{} `yaml:"-"`
+}
+
+// newJournalDocument returns the editable fields of a journal entry.
+func newJournalDocument(journal *client.ReadingJournal) *journalDocument {
+	document := &journalDocument{
+		Privacy:  journal.PrivacySettingID.String(),
+		Text:     journal.Entry,
+		Metadata: journal.Metadata,
+	}
+	if page := journal.Page(); page > 0 {
+		document.Page = &page
+	}
+	return document
+}
+
+// journalAddDocument builds the entry to add from the journal add flags and
+// text, and reports whether it is a quote.
+func journalAddDocument(cmd *cobra.Command, text string) (*journalDocument, bool, error) {
+	document := &journalDocument{Privacy: client.PrivacyPublic.String(), Text: strings.TrimSpace(text)}
+	var isQuote bool
+	if err := readBoolFlags(cmd, map[string]*bool{"quote": &isQuote}); err != nil {
+		return nil, false, err
+	}
+	if err := readStringFlags(cmd, map[string]*string{"privacy": &document.Privacy}); err != nil {
+		return nil, false, err
+	}
+	if flag := cmd.Flags().Lookup("page"); flag != nil && flag.Changed {
+		page, err := cmd.Flags().GetInt("page")
+		if err != nil {
+			return nil, false, err
+		}
+		document.Page = &page
+	}
+	if err := document.validate(); err != nil {
+		return nil, false, err
+	}
+	return document, isQuote, nil
+}
+
+// validate checks the page and privacy of the entry.
+func (d *journalDocument) validate() error {
+	if d.Page != nil && *d.Page <= 0 {
+		return fmt.Errorf("invalid page %d: use a positive whole number", *d.Page)
+	}
+	_, err := client.ParsePrivacySetting(d.Privacy)
+	return err
+}
+
+// page returns the entry's page, or zero.
+func (d *journalDocument) page() int {
+	if d.Page == nil {
+		return 0
+	}
+	return *d.Page
+}
+
+// input returns the changes that turn current into the entry, or the whole
+// entry when current is nil.
+func (d *journalDocument) input(current *journalDocument) (*client.ReadingJournalInput, error) {
+	if d.Text == "" {
+		return nil, errors.New("the journal entry is empty; nothing was saved")
+	}
+	privacy, err := client.ParsePrivacySetting(d.Privacy)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &client.ReadingJournalInput{}
+	if current == nil || d.Text != current.Text {
+		input.Entry = &d.Text
+	}
+	if current == nil || privacy.String() != current.Privacy {
+		input.PrivacySettingID = privacy
+	}
+	if current == nil || d.page() != current.page() {
+		if err := input.SetPage(d.Metadata, d.page()); err != nil {
+			return nil, err
+		}
+	}
+	return input, nil
+}
+
+// editJournalDocument lets the user edit an entry, keeping the draft when it
+// is invalid.
+func editJournalDocument(current *journalDocument) (*journalDocument, error) {
+	text, err := formatFrontmatter(journalHelp, current, current.Text)
+	if err != nil {
+		return nil, err
+	}
+	edited, draft, err := editText(text, "journal-*.md")
+	if err != nil {
+		return nil, err
+	}
+
+	document := *current
+	if current.Page != nil {
+		// Decoding writes through a non-nil pointer; keep current intact.
+		page := *current.Page
+		document.Page = &page
+	}
+	body, err := parseFrontmatter(edited, &document)
+	if err == nil {
+		document.Text = body
+		err = document.validate()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w\nYour draft is saved in %s", err, draft)
+	}
+	_ = os.Remove(draft)
+	return &document, nil
+}
+
+// journalEntryView is a journal entry in the journal commands' output.
+// Action is set by the commands that change an entry.
+type journalEntryView struct {
+	Action    string `json:"action,omitempty"`
+	ID        int    `json:"id"`
+	BookID    int    `json:"book_id"`
+	Title     string `json:"title"`
+	Event     string `json:"event"`
+	Entry     string `json:"entry"`
+	Page      int    `json:"page"`
+	Privacy   string `json:"privacy"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// newJournalEntryView converts a client journal entry into its output
+// representation. title is used when the entry carries no book.
+func newJournalEntryView(journal *client.ReadingJournal, title string) *journalEntryView {
+	view := &journalEntryView{
+		ID:        journal.ID,
+		BookID:    journal.BookID,
+		Title:     title,
+		Event:     journal.Event,
+		Entry:     journal.Entry,
+		Page:      journal.Page(),
+		CreatedAt: journal.CreatedAt,
+		UpdatedAt: journal.UpdatedAt,
+	}
+	if journal.PrivacySettingID != 0 {
+		view.Privacy = journal.PrivacySettingID.String()
+	}
+	if journal.Book != nil && journal.Book.Title != "" {
+		view.Title = journal.Book.Title
+	}
+	return view
+}
+
+// describeJournalEvent turns an event name into words, e.g.
+// "progress_updated" into "Progress updated".
+func describeJournalEvent(event string) string {
+	words := strings.ReplaceAll(event, "_", " ")
+	if words == "" {
+		return "Entry"
+	}
+	return strings.ToUpper(words[:1]) + words[1:]
+}
+
+// formatTimestamp shortens an API timestamp to the minute.
+func formatTimestamp(timestamp string) string {
+	formatted := strings.Replace(timestamp, "T", " ", 1)
+	if len(formatted) > timestampLength {
+		formatted = formatted[:timestampLength]
+	}
+	return formatted
+}
+
+// printJournalEntryViews writes a book's journal as human-readable text.
+func printJournalEntryViews(w io.Writer, title string, entries []journalEntryView) {
+	if len(entries) == 0 {
+		printToStdoutf(w, "No journal entries for %q.\n", title)
+		return
+	}
+
+	printToStdoutf(w, "Journal for %q (%s)\n\n", title, pluralize(len(entries), "entry", "entries"))
+	for i := range entries {
+		entry := &entries[i]
+		parts := []string{formatTimestamp(entry.CreatedAt), describeJournalEvent(entry.Event)}
+		if entry.Page > 0 {
+			parts = append(parts, "p. "+strconv.Itoa(entry.Page))
+		}
+		if entry.Privacy != "" {
+			parts = append(parts, entry.Privacy)
+		}
+		printToStdoutf(w, "#%d  %s\n", entry.ID, strings.Join(parts, " · "))
+		for _, line := range strings.Split(entry.Entry, "\n") {
+			if line != "" {
+				printToStdoutf(w, "   %s\n", line)
+			}
+		}
+		printSearchSeparator(w)
+	}
+}
+
+// renderJournalChange writes the outcome of a journal add, edit or delete.
+func renderJournalChange(cmd *cobra.Command, view *journalEntryView) error {
+	return render(cmd, view, func(w io.Writer) {
+		switch view.Action {
+		case libraryActionAdded:
+			printToStdoutf(w, "Added a %s to your journal for %q (entry %d).\n", view.Event, view.Title, view.ID)
+		case libraryActionUnchanged:
+			printToStdoutf(w, "Journal entry %d is unchanged.\n", view.ID)
+		case libraryActionRemoved:
+			printToStdoutf(w, "Deleted journal entry %d from %q.\n", view.ID, view.Title)
+		default:
+			printToStdoutf(w, "Updated journal entry %d for %q.\n", view.ID, view.Title)
+		}
+	})
+}
+
+// journalSummaryView is a book in the journal summary command's output.
+type journalSummaryView struct {
+	BookID        int    `json:"book_id"`
+	Title         string `json:"title"`
+	Entries       int    `json:"entries"`
+	LastUpdatedAt string `json:"last_updated_at"`
+	URL           string `json:"url"`
+}
+
+// newJournalSummaryView converts a client journal summary into its output
+// representation.
+func newJournalSummaryView(summary *client.ReadingJournalSummary) journalSummaryView {
+	view := journalSummaryView{
+		BookID:        summary.BookID,
+		Entries:       summary.JournalsCount,
+		LastUpdatedAt: summary.LastUpdatedAt,
+	}
+	if book := summary.Book; book != nil {
+		view.Title = book.Title
+		if book.Slug != "" {
+			view.URL = "https://hardcover.app/books/" + book.Slug
+		}
+	}
+	return view
+}
+
+// printJournalSummaryViews writes the journal summary as human-readable
+// text.
+func printJournalSummaryViews(w io.Writer, books []journalSummaryView) {
+	if len(books) == 0 {
+		printToStdoutf(w, "Your reading journal is empty.\n")
+		return
+	}
+
+	total := 0
+	for i := range books {
+		total += books[i].Entries
+	}
+	printToStdoutf(w, "Your reading journal (%s, %s)\n\n",
+		pluralize(len(books), "book", "books"), pluralize(total, "entry", "entries"))
+	for i := range books {
+		book := &books[i]
+		line := fmt.Sprintf("%5d  %s", book.Entries, book.Title)
+		if book.LastUpdatedAt != "" {
+			line += " (last updated " + formatTimestamp(book.LastUpdatedAt)[:len(time.DateOnly)] + ")"
+		}
+		printToStdoutf(w, "%s\n", line)
+	}
+}
+
+// addJournalAddFlags registers the flags of the journal add command.
+func addJournalAddFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("quote", false, "add a quote rather than a note")
+	cmd.Flags().Int("page", 0, "the page the entry refers to")
+	cmd.Flags().String("privacy", client.PrivacyPublic.String(),
+		"who can see the entry ("+strings.Join(client.PrivacySettingNames(), ", ")+")")
+}
+
+// addJournalListFlags registers the flags of the journal list command.
+func addJournalListFlags(cmd *cobra.Command) {
+	cmd.Flags().String("type", "", "only these entry types, comma-separated, e.g. \"note,quote\"")
+}
+
+// setupJournalCommands registers the journal commands with the root command.
+func setupJournalCommands() {
+	addJournalAddFlags(journalAddCmd)
+	journalCmd.AddCommand(journalAddCmd)
+	addJournalListFlags(journalListCmd)
+	journalCmd.AddCommand(journalListCmd)
+	journalCmd.AddCommand(journalEditCmd)
+	journalCmd.AddCommand(journalDeleteCmd)
+	journalCmd.AddCommand(journalSummaryCmd)
+	rootCmd.AddCommand(journalCmd)
+}
@@ -0,0 +1,226 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// duneJournal is a journal for Dune: a public note on page 142 and a private
+// progress update.
+func duneJournal() []map[string]interface{} {
+	book := map[string]interface{}{"id": 328491, "title": "Dune", "slug": "dune"}
+	return []map[string]interface{}{
+		{
+			"id": 900, "book_id": 328491, "event": "note", "entry": "Paul's visions are unreliable.\nOr are they?",
+			"metadata": map[string]interface{}{"page": 142, "chapter": "3"}, "privacy_setting_id": 1,
+			"created_at": "2024-03-01T12:34:56+00:00", "book": book,
+		},
+		{
+			"id": 901, "book_id": 328491, "event": "progress_updated", "entry": "",
+			"metadata": map[string]interface{}{}, "privacy_setting_id": 3,
+			"created_at": "2024-03-02T08:00:00+00:00", "book": book,
+		},
+	}
+}
+
+// journalPage answers GetReadingJournals: a single entry when the query
+// selects one by ID, otherwise the page of all entries selected by limit and
+// offset.
+func (l *fakeLibrary) journalPage(variables map[string]interface{}) []interface{} {
+	where := variables["where"].(map[string]interface{})
+	offset, limit := int(variables["offset"].(float64)), int(variables["limit"].(float64))
+	page := make([]interface{}, 0, limit)
+	for i, journal := range l.journals {
+		if id, ok := where["id"].(map[string]interface{}); ok && float64(journal["id"].(int)) != id["_eq"] {
+			continue
+		}
+		if i >= offset && len(page) < limit {
+			page = append(page, journal)
+		}
+	}
+	return page
+}
+
+// journalSummary answers GetReadingJournalsSummary with a single row
+// counting every entry.
+func (l *fakeLibrary) journalSummary() []interface{} {
+	if len(l.journals) == 0 {
+		return []interface{}{}
+	}
+	return []interface{}{map[string]interface{}{
+		"book_id": 328491, "journals_count": len(l.journals), "last_updated_at": "2024-03-02T08:00:00+00:00",
+		"book": map[string]interface{}{"id": 328491, "title": "Dune", "slug": "dune"},
+	}}
+}
+
+// mutateJournal applies a journal mutation.
+func (l *fakeLibrary) mutateJournal(operation string, variables map[string]interface{}) interface{} {
+	switch operation {
+	case "InsertReadingJournal":
+		l.nextID++
+		journal := map[string]interface{}{"id": l.nextID}
+		l.apply(journal, variables["object"].(map[string]interface{}))
+		l.journals = append(l.journals, journal)
+		return map[string]interface{}{"insert_reading_journal": map[string]interface{}{"id": l.nextID, "reading_journal": journal}}
+	case "UpdateReadingJournal":
+		return map[string]interface{}{"update_reading_journal": map[string]interface{}{"id": variables["id"]}}
+	default:
+		return map[string]interface{}{"delete_reading_journal": map[string]interface{}{"id": variables["id"]}}
+	}
+}
+
+func TestJournalAddCmd_Quote(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	cmd, output := newFlagTestCommand(t, url, "text", addJournalAddFlags,
+		"--quote", "--page", "8", "--privacy", "private")
+
+	require.NoError(t, journalAddCmd.RunE(cmd, []string{"dune", "Fear", "is", "the", "mind-killer."}))
+
+	assert.Equal(t, "Added a quote to your journal for \"Dune\" (entry 501).\n", output.String())
+	require.Equal(t, []string{"InsertReadingJournal"}, library.operations())
+	assert.Equal(t, map[string]interface{}{
+		"book_id": float64(328491), "event": "quote", "entry": "Fear is the mind-killer.",
+		"privacy_setting_id": float64(3), "metadata": map[string]interface{}{"page": float64(8)},
+	}, library.mutations[0].Variables["object"])
+}
+
+func TestJournalAddCmd_Editor(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	var opened string
+	stubEditor(t, func(document string) string {
+		opened = document
+		return document + "The spice must flow.\n"
+	})
+	cmd, output := newFlagTestCommand(t, url, "json", addJournalAddFlags)
+
+	require.NoError(t, journalAddCmd.RunE(cmd, []string{"dune"}))
+
+	assert.Contains(t, opened, "page:\nprivacy: public\n---\n")
+	assert.Contains(t, output.String(), `"action": "added"`)
+	assert.Contains(t, output.String(), `"event": "note"`)
+	require.Len(t, library.mutations, 1)
+	object := library.mutations[0].Variables["object"].(map[string]interface{})
+	assert.Equal(t, "The spice must flow.", object["entry"])
+	assert.Equal(t, float64(1), object["privacy_setting_id"])
+}
+
+func TestJournalAddCmd_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   []string
+		wantErr string
+	}{
+		{name: "empty entry", wantErr: "the journal entry is empty"},
+		{name: "privacy", flags: []string{"--privacy", "friends"}, wantErr: `unknown privacy setting "friends"`},
+		{name: "page", flags: []string{"--page", "0"}, wantErr: "invalid page 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			library, url := newFakeLibrary(t, nil)
+			stubEditor(t, func(document string) string { return document })
+			cmd, _ := newFlagTestCommand(t, url, "text", addJournalAddFlags, tt.flags...)
+
+			err := journalAddCmd.RunE(cmd, []string{"dune"})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Empty(t, library.mutations)
+		})
+	}
+}
+
+func TestJournalListCmd(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.journals = duneJournal()
+	cmd, output := newFlagTestCommand(t, url, "text", addJournalListFlags, "--type", "note,progress_updated")
+
+	require.NoError(t, journalListCmd.RunE(cmd, []string{"dune"}))
+
+	assert.Equal(t, "Journal for \"Dune\" (2 entries)\n\n"+
+		"#900  2024-03-01 12:34 · Note · p. 142 · public\n"+
+		"   Paul's visions are unreliable.\n   Or are they?\n\n"+strings.Repeat("-", 29)+"\n"+
+		"#901  2024-03-02 08:00 · Progress updated · private\n\n"+strings.Repeat("-", 29)+"\n", output.String())
+	require.Len(t, library.queries, 1)
+	assert.Equal(t, map[string]interface{}{"_and": []interface{}{
+		map[string]interface{}{"user_id": map[string]interface{}{"_eq": float64(42)}},
+		map[string]interface{}{"book_id": map[string]interface{}{"_eq": float64(328491)}},
+		map[string]interface{}{"event": map[string]interface{}{"_in": []interface{}{"note", "progress_updated"}}},
+	}}, library.queries[0].Variables["where"])
+}
+
+func TestJournalEditCmd_ChangesPage(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.journals = duneJournal()
+	var opened string
+	stubEditor(t, func(document string) string {
+		opened = document
+		return strings.Replace(document, "page: 142\n", "page: 150\n", 1)
+	})
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, journalEditCmd.RunE(cmd, []string{"900"}))
+
+	assert.True(t, strings.HasPrefix(opened, "---\n# page: the page the entry refers to"))
+	assert.Contains(t, opened, "page: 142\nprivacy: public\n---\n\nPaul's visions are unreliable.\nOr are they?\n")
+	assert.Equal(t, "Updated journal entry 900 for \"Dune\".\n", output.String())
+	require.Equal(t, []string{"UpdateReadingJournal"}, library.operations())
+	assert.Equal(t, float64(900), library.mutations[0].Variables["id"])
+	assert.Equal(t, map[string]interface{}{
+		"metadata": map[string]interface{}{"page": float64(150), "chapter": "3"},
+	}, library.mutations[0].Variables["object"])
+}
+
+func TestJournalEditCmd_Unchanged(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.journals = duneJournal()
+	stubEditor(t, func(document string) string { return document })
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, journalEditCmd.RunE(cmd, []string{"900"}))
+
+	assert.Equal(t, "Journal entry 900 is unchanged.\n", output.String())
+	assert.Empty(t, library.mutations)
+}
+
+func TestJournalEditCmd_NotFound(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	cmd, _ := newBookTestCommand(url, "text")
+
+	err := journalEditCmd.RunE(cmd, []string{"5"})
+	require.Error(t, err)
+	assert.Equal(t, "journal entry 5 not found", err.Error())
+	assert.Empty(t, library.mutations)
+
+	err = journalEditCmd.RunE(cmd, []string{"five"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid journal entry ID "five"`)
+}
+
+func TestJournalDeleteCmd(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.journals = duneJournal()
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, journalDeleteCmd.RunE(cmd, []string{"901"}))
+
+	assert.Equal(t, "Deleted journal entry 901 from \"Dune\".\n", output.String())
+	require.Equal(t, []string{"DeleteReadingJournal"}, library.operations())
+	assert.Equal(t, float64(901), library.mutations[0].Variables["id"])
+}
+
+func TestJournalSummaryCmd(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, journalSummaryCmd.RunE(cmd, nil))
+	assert.Equal(t, "Your reading journal is empty.\n", output.String())
+
+	library.journals = duneJournal()
+	output.Reset()
+	require.NoError(t, journalSummaryCmd.RunE(cmd, nil))
+	assert.Equal(t, "Your reading journal (1 book, 2 entries)\n\n"+
+		"    2  Dune (last updated 2024-03-02)\n", output.String())
+}
@@ -0,0 +1,442 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/isbn"
+)
+
+// Outcomes of a library change.
+const (
+	libraryActionAdded      = "added"
+	libraryActionUpdated    = "updated"
+	libraryActionUnchanged  = "unchanged"
+	libraryActionRemoved    = "removed"
+	libraryActionNotShelved = "not_shelved"
+	libraryActionShown      = "shown"
+)
+
+// libraryCmd represents the library command.
+var libraryCmd = &cobra.Command{
+	Use:   "library",
+	Short: "Manage the books on your shelves",
+	Long: `Commands for managing the books in your Hardcover library.
+
+Books are identified by Hardcover ID, URL slug or the ISBN of any edition.
+Statuses can be given by slug, name or a common alias:
+  want-to-read (want, tbr), currently-reading (reading), read, paused,
+  did-not-finish (dnf) and ignored
+
+Every command is safe to repeat: shelving a book that is already on your
+shelves only changes what differs, and removing a book that is not on
+your shelves does nothing.
+
+Available subcommands:
+  list       List the books in your library
+  add        Add a book to your library
+  status     Show or change a book's reading status
+  edition    Choose the edition you own or are reading
+  remove     Remove a book from your library`,
+}
+
+// libraryAddCmd represents the library add command.
+var libraryAddCmd = &cobra.Command{
+	Use:   "add <book>",
+	Short: "Add a book to your library",
+	Long: `Add a book to your library, identified by Hardcover ID, URL slug or ISBN.
+
+New books are shelved as Want to Read unless --status is given. When the
+book is identified by ISBN, the edition with that ISBN is chosen unless
+--edition is given.
+
+If the book is already in your library, only the given status and edition
+are applied, so the command can be repeated safely.
+
+Example:
+  hardcover library add dune
+  hardcover library add 328491 --status reading
+  hardcover library add 9780441013593 --status read
+  hardcover library add dune --edition 31`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var statusName, editionID string
+		if err := readStringFlags(cmd, map[string]*string{
+			"status":  &statusName,
+			"edition": &editionID,
+		}); err != nil {
+			return err
+		}
+		var status client.UserBookStatus
+		if statusName != "" {
+			var err error
+			if status, err = client.ParseUserBookStatus(statusName); err != nil {
+				return err
+			}
+		}
+		if editionID == "" && isbn.LooksLike(args[0]) {
+			editionID = args[0]
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		book, err := resolveLibraryBook(ctx, gqlClient, args[0])
+		if err != nil {
+			return err
+		}
+		var edition *client.EditionDetail
+		if editionID != "" {
+			if edition, err = resolveEdition(ctx, gqlClient, book, editionID); err != nil {
+				return err
+			}
+		}
+
+		view, err := shelveBook(ctx, gqlClient, book, status, edition, true)
+		if err != nil {
+			return err
+		}
+		return renderLibraryEntry(cmd, view)
+	},
+}
+
+// libraryStatusCmd represents the library status command.
+var libraryStatusCmd = &cobra.Command{
+	Use:   "status <book> [status]",
+	Short: "Show or change a book's reading status",
+	Long: `Show the reading status of a book in your library or, when a status is
+given, change it.
+
+Example:
+  hardcover library status dune
+  hardcover library status dune reading
+  hardcover library status 328491 dnf`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var status client.UserBookStatus
+		if len(args) == 2 {
+			var err error
+			if status, err = client.ParseUserBookStatus(args[1]); err != nil {
+				return err
+			}
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		book, err := resolveLibraryBook(ctx, gqlClient, args[0])
+		if err != nil {
+			return err
+		}
+
+		var view *libraryEntryView
+		if status == 0 {
+			view, err = showShelvedBook(ctx, gqlClient, book)
+		} else {
+			view, err = shelveBook(ctx, gqlClient, book, status, nil, false)
+		}
+		if err != nil {
+			return err
+		}
+		return renderLibraryEntry(cmd, view)
+	},
+}
+
+// libraryEditionCmd represents the library edition command.
+var libraryEditionCmd = &cobra.Command{
+	Use:   "edition <book> <edition-id|isbn>",
+	Short: "Choose the edition you own or are reading",
+	Long: `Choose which edition of a book in your library you own or are reading,
+identified by edition ID or ISBN. Use "hardcover book editions <book>" to
+list the editions of a book.
+
+Example:
+  hardcover library edition dune 31
+  hardcover library edition dune 9780441013593`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		book, err := resolveLibraryBook(ctx, gqlClient, args[0])
+		if err != nil {
+			return err
+		}
+		edition, err := resolveEdition(ctx, gqlClient, book, args[1])
+		if err != nil {
+			return err
+		}
+
+		view, err := shelveBook(ctx, gqlClient, book, 0, edition, false)
+		if err != nil {
+			return err
+		}
+		return renderLibraryEntry(cmd, view)
+	},
+}
+
+// libraryRemoveCmd represents the library remove command.
+var libraryRemoveCmd = &cobra.Command{
+	Use:   "remove <book>",
+	Short: "Remove a book from your library",
+	Long: `Remove a book from your library, together with its status, rating, review
+and reading dates. Removing a book that is not in your library does nothing.
+
+Example:
+  hardcover library remove dune`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		book, err := resolveLibraryBook(ctx, gqlClient, args[0])
+		if err != nil {
+			return err
+		}
+
+		view := &libraryEntryView{BookID: book.ID, Title: book.Title, Action: libraryActionNotShelved}
+		userBook, err := gqlClient.GetMyUserBook(ctx, book.ID)
+		switch {
+		case errors.Is(err, client.ErrNotFound):
+		case err != nil:
+			return fmt.Errorf("failed to get library entry: %w", err)
+		default:
+			if err := gqlClient.DeleteUserBook(ctx, userBook.ID); err != nil {
+				return fmt.Errorf("failed to remove book: %w", err)
+			}
+			view.UserBookID = userBook.ID
+			view.Action = libraryActionRemoved
+		}
+		return renderLibraryEntry(cmd, view)
+	},
+}
+
+// resolveLibraryBook looks a book up by ISBN, ID or slug, reporting unknown
+// books by their identifier.
+func resolveLibraryBook(ctx context.Context, c *client.Client, identifier string) (*client.BookDetail, error) {
+	book, err := resolveBook(ctx, c, identifier)
+	if errors.Is(err, client.ErrNotFound) {
+		return nil, fmt.Errorf("book %q %w", identifier, client.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get book: %w", err)
+	}
+	return book, nil
+}
+
+// resolveEdition looks an edition of book up by edition ID or ISBN, failing
+// when it belongs to a different book.
+func resolveEdition(ctx context.Context, c *client.Client, book *client.BookDetail, identifier string) (*client.EditionDetail, error) {
+	var edition *client.EditionDetail
+	if isbn.LooksLike(identifier) {
+		number, err := isbn.Parse(identifier)
+		if err != nil {
+			return nil, err
+		}
+		editions, err := c.GetEditionsByISBN(ctx, []isbn.ISBN{number})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get edition: %w", err)
+		}
+		for i := range editions {
+			if editions[i].Book != nil && editions[i].Book.ID == book.ID {
+				edition = &editions[i]
+				break
+			}
+		}
+	} else {
+		id, err := strconv.Atoi(identifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid edition %q: use an edition ID or ISBN", identifier)
+		}
+		edition, err = c.GetEdition(ctx, id)
+		if err != nil && !errors.Is(err, client.ErrNotFound) {
+			return nil, fmt.Errorf("failed to get edition: %w", err)
+		}
+	}
+
+	if edition == nil || edition.Book == nil || edition.Book.ID != book.ID {
+		return nil, fmt.Errorf("edition %q of %q %w", identifier, book.Title, client.ErrNotFound)
+	}
+	return edition, nil
+}
+
+// shelveBook applies status and edition to the user's entry for book,
+// changing only what differs. Zero values leave a field unchanged. Books
+// that are not shelved yet are added as Want to Read by default when create
+// is set, and reported as an error otherwise.
+func shelveBook(
+	ctx context.Context, c *client.Client, book *client.BookDetail,
+	status client.UserBookStatus, edition *client.EditionDetail, create bool,
+) (*libraryEntryView, error) {
+	existing, err := c.GetMyUserBook(ctx, book.ID)
+	if errors.Is(err, client.ErrNotFound) {
+		if !create {
+			return nil, fmt.Errorf("%q is not in your library; add it with \"hardcover library add\"", book.Title)
+		}
+		input := &client.UserBookInput{BookID: book.ID, StatusID: status}
+		if input.StatusID == 0 {
+			input.StatusID = client.StatusWantToRead
+		}
+		if edition != nil {
+			input.EditionID = &edition.ID
+		}
+		added, addErr := c.AddUserBook(ctx, input)
+		if addErr != nil {
+			return nil, fmt.Errorf("failed to add book: %w", addErr)
+		}
+		return newLibraryEntryView(book, added, libraryActionAdded, 0), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get library entry: %w", err)
+	}
+
+	input := &client.UserBookInput{}
+	if status != 0 && status != existing.StatusID {
+		input.StatusID = status
+	}
+	if edition != nil && (existing.EditionID == nil || *existing.EditionID != edition.ID) {
+		input.EditionID = &edition.ID
+	}
+	if input.IsEmpty() {
+		return newLibraryEntryView(book, existing, libraryActionUnchanged, 0), nil
+	}
+
+	updated, err := c.UpdateUserBook(ctx, existing.ID, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update book: %w", err)
+	}
+	return newLibraryEntryView(book, updated, libraryActionUpdated, existing.StatusID), nil
+}
+
+// showShelvedBook describes the user's entry for book.
+func showShelvedBook(ctx context.Context, c *client.Client, book *client.BookDetail) (*libraryEntryView, error) {
+	userBook, err := c.GetMyUserBook(ctx, book.ID)
+	if errors.Is(err, client.ErrNotFound) {
+		return &libraryEntryView{BookID: book.ID, Title: book.Title, Action: libraryActionNotShelved}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get library entry: %w", err)
+	}
+	return newLibraryEntryView(book, userBook, libraryActionShown, 0), nil
+}
+
+// libraryEntryView is the structured form of a library command's outcome.
+type libraryEntryView struct {
+	Action         string `json:"action"`
+	UserBookID     int    `json:"user_book_id"`
+	BookID         int    `json:"book_id"`
+	Title          string `json:"title"`
+	Status         string `json:"status"`
+	PreviousStatus string `json:"previous_status"`
+	EditionID      int    `json:"edition_id"`
+	Edition        string `json:"edition"`
+}
+
+// newLibraryEntryView describes the outcome of a library change. previous
+// is the status before an update, or zero.
+func newLibraryEntryView(
+	book *client.BookDetail, userBook *client.UserBook, action string, previous client.UserBookStatus,
+) *libraryEntryView {
+	view := &libraryEntryView{
+		Action:     action,
+		UserBookID: userBook.ID,
+		BookID:     book.ID,
+		Title:      book.Title,
+	}
+	if userBook.StatusID != 0 {
+		view.Status = userBook.StatusID.String()
+	}
+	if previous != 0 && previous != userBook.StatusID {
+		view.PreviousStatus = previous.String()
+	}
+	if userBook.EditionID != nil {
+		view.EditionID = *userBook.EditionID
+	}
+	if userBook.Edition != nil {
+		view.Edition = describeUserBookEdition(userBook.Edition)
+	}
+	return view
+}
+
+// describeUserBookEdition formats an edition as "Title (Format)".
+func describeUserBookEdition(edition *client.UserBookEdition) string {
+	parts := []string{edition.Title}
+	if format := edition.Format(); format != "" {
+		parts = append(parts, "("+format+")")
+	}
+	return strings.TrimSpace(strings.Join(parts, " "))
+}
+
+// renderLibraryEntry writes the outcome of a library command.
+func renderLibraryEntry(cmd *cobra.Command, view *libraryEntryView) error {
+	return render(cmd, view, func(w io.Writer) {
+		printLibraryEntry(w, view)
+	})
+}
+
+// printLibraryEntry writes a one-line summary of a library change, followed
+// by the chosen edition.
+func printLibraryEntry(w io.Writer, entry *libraryEntryView) {
+	switch entry.Action {
+	case libraryActionAdded:
+		printToStdoutf(w, "Added %q to your library as %s.\n", entry.Title, entry.Status)
+	case libraryActionUpdated:
+		if entry.PreviousStatus != "" {
+			printToStdoutf(w, "Updated %q: %s → %s.\n", entry.Title, entry.PreviousStatus, entry.Status)
+		} else {
+			printToStdoutf(w, "Updated %q.\n", entry.Title)
+		}
+	case libraryActionUnchanged:
+		printToStdoutf(w, "%q is already in your library as %s.\n", entry.Title, entry.Status)
+	case libraryActionShown:
+		printToStdoutf(w, "%q is in your library as %s.\n", entry.Title, entry.Status)
+	case libraryActionRemoved:
+		printToStdoutf(w, "Removed %q from your library.\n", entry.Title)
+		return
+	case libraryActionNotShelved:
+		printToStdoutf(w, "%q is not in your library.\n", entry.Title)
+		return
+	}
+
+	if entry.Edition != "" {
+		printToStdoutf(w, "  Edition: %s (ID %d)\n", entry.Edition, entry.EditionID)
+	}
+}
+
+// addLibraryAddFlags registers the flags of the library add command.
+func addLibraryAddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("status", "s", "",
+		"reading status ("+strings.Join(client.UserBookStatusSlugs(), ", ")+"); default want-to-read for new books")
+	cmd.Flags().StringP("edition", "e", "", "edition ID or ISBN of the edition you own or are reading")
+}
+
+// setupLibraryCommands registers the library commands with the root command.
+func setupLibraryCommands() {
+	addLibraryListFlags(libraryListCmd)
+	libraryCmd.AddCommand(libraryListCmd)
+	addLibraryAddFlags(libraryAddCmd)
+	libraryCmd.AddCommand(libraryAddCmd)
+	libraryCmd.AddCommand(libraryStatusCmd)
+	libraryCmd.AddCommand(libraryEditionCmd)
+	libraryCmd.AddCommand(libraryRemoveCmd)
+	rootCmd.AddCommand(libraryCmd)
+}
@@ -0,0 +1,287 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// defaultLibrarySort lists the most recently added books first.
+const defaultLibrarySort = "date_added:desc"
+
+// maxRating is the highest rating a book can be given.
+const maxRating = 5
+
+// rangeSeparator separates the bounds of a --rating or --added range.
+const rangeSeparator = ".."
+
+// libraryListCmd represents the library list command.
+var libraryListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the books in your library",
+	Long: `List every book in your library, fetching all pages.
+
+Filters:
+  --status     One or more statuses, comma-separated, e.g. "read,dnf"
+  --rating     Your rating: a value or an inclusive range, e.g. "5", "4..5",
+               "3.5.." or "..2"
+  --added      Date added: a day or an inclusive range of YYYY-MM-DD dates,
+               e.g. "2024-01-01..2024-06-30" or "2024-01-01.."
+  --owned      Only books you own (--owned=false for books you don't)
+  --starred    Only starred books (--starred=false for unstarred books)
+  --format     Reading format, e.g. "audiobook" or "ebook" (substring match)
+  --tag        One of your tags, by name or slug
+
+Sorting:
+  --sort       date_added, rating or title, with an optional :asc or :desc
+               (default date_added:desc, the most recently added first)
+
+Example:
+  hardcover library list
+  hardcover library list --status reading
+  hardcover library list --status read --rating 4.5.. --sort rating:desc
+  hardcover library list --added 2024-01-01..2024-12-31 --owned -o csv
+  hardcover library list --format audiobook --tag favorites -o json
+
+When the API fails to load some fields, the books it did return are still
+listed and a warning naming each failed field is printed on standard error.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		filter, err := userBookFilter(cmd)
+		if err != nil {
+			return err
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		if filter.UserID, err = gqlClient.CurrentUserID(ctx); err != nil {
+			return fmt.Errorf("failed to get user profile: %w", err)
+		}
+		userBooks, warnings, err := gqlClient.GetUserBooksPartial(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to get library: %w", err)
+		}
+		printWarnings(cmd.ErrOrStderr(), warnings)
+
+		views := make([]libraryBookView, len(userBooks))
+		for i := range userBooks {
+			views[i] = newLibraryBookView(&userBooks[i])
+		}
+		return render(cmd, views, func(w io.Writer) {
+			printLibraryBookViews(w, views)
+		})
+	},
+}
+
+// userBookFilter builds the client filter from the command's flags.
+func userBookFilter(cmd *cobra.Command) (*client.UserBookFilter, error) {
+	filter := &client.UserBookFilter{}
+	var statuses, rating, added string
+	if err := readStringFlags(cmd, map[string]*string{
+		"status": &statuses,
+		"rating": &rating,
+		"added":  &added,
+		"format": &filter.ReadingFormat,
+		"tag":    &filter.Tag,
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, name := range client.SplitList(statuses) {
+		status, err := client.ParseUserBookStatus(name)
+		if err != nil {
+			return nil, err
+		}
+		filter.Statuses = append(filter.Statuses, status)
+	}
+
+	var err error
+	if filter.MinRating, filter.MaxRating, err = parseRatingRange(rating); err != nil {
+		return nil, err
+	}
+	if filter.AddedFrom, filter.AddedTo, err = parseDateRange(added); err != nil {
+		return nil, err
+	}
+	if filter.Owned, err = optionalBoolFlag(cmd, "owned"); err != nil {
+		return nil, err
+	}
+	if filter.Starred, err = optionalBoolFlag(cmd, "starred"); err != nil {
+		return nil, err
+	}
+	if filter.OrderBy, filter.Descending, err = sortFlag(cmd, defaultLibrarySort); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// splitRange splits "low..high" into its bounds; either may be empty. A
+// single value is both bounds.
+func splitRange(value string) (string, string) {
+	low, high, isRange := strings.Cut(value, rangeSeparator)
+	if !isRange {
+		return value, value
+	}
+	return strings.TrimSpace(low), strings.TrimSpace(high)
+}
+
+// parseRatingRange parses a --rating value into inclusive bounds, zero for
+// an open end.
+func parseRatingRange(value string) (float64, float64, error) {
+	if value == "" {
+		return 0, 0, nil
+	}
+
+	low, high := splitRange(value)
+	bounds := make([]float64, 2)
+	for i, bound := range []string{low, high} {
+		if bound == "" {
+			continue
+		}
+		rating, err := strconv.ParseFloat(bound, 64)
+		if err != nil || rating < 0 || rating > maxRating {
+			return 0, 0, fmt.Errorf("invalid rating %q in %q: use a number from 0 to %d", bound, value, maxRating)
+		}
+		bounds[i] = rating
+	}
+	if bounds[1] != 0 && bounds[0] > bounds[1] {
+		return 0, 0, fmt.Errorf("invalid rating range %q: the lower bound is above the upper bound", value)
+	}
+	return bounds[0], bounds[1], nil
+}
+
+// parseDateRange parses an --added value into inclusive YYYY-MM-DD bounds,
+// empty for an open end.
+func parseDateRange(value string) (string, string, error) {
+	if value == "" {
+		return "", "", nil
+	}
+
+	low, high := splitRange(value)
+	for _, bound := range []string{low, high} {
+		if bound == "" {
+			continue
+		}
+		if _, err := time.Parse(time.DateOnly, bound); err != nil {
+			return "", "", fmt.Errorf("invalid date %q in %q: use YYYY-MM-DD", bound, value)
+		}
+	}
+	if low != "" && high != "" && low > high {
+		return "", "", fmt.Errorf("invalid date range %q: the start is after the end", value)
+	}
+	return low, high, nil
+}
+
+// formatRating formats a rating without trailing zeros, e.g. "4.5".
+func formatRating(rating float64) string {
+	return strconv.FormatFloat(rating, 'f', -1, 64)
+}
+
+// libraryBookView is a book in the library list command's output.
+type libraryBookView struct {
+	UserBookID    int     `json:"user_book_id"`
+	BookID        int     `json:"book_id"`
+	Title         string  `json:"title"`
+	Authors       string  `json:"authors"`
+	Status        string  `json:"status"`
+	Rating        float64 `json:"rating"`
+	Owned         bool    `json:"owned"`
+	Starred       bool    `json:"starred"`
+	DateAdded     string  `json:"date_added"`
+	LastReadDate  string  `json:"last_read_date"`
+	ReadingFormat string  `json:"reading_format"`
+	EditionID     int     `json:"edition_id"`
+	Edition       string  `json:"edition"`
+	URL           string  `json:"url"`
+}
+
+// newLibraryBookView converts a client shelf entry into its output
+// representation.
+func newLibraryBookView(userBook *client.UserBook) libraryBookView {
+	view := libraryBookView{
+		UserBookID:   userBook.ID,
+		BookID:       userBook.BookID,
+		Status:       userBook.StatusID.String(),
+		Rating:       userBook.Rating,
+		Owned:        userBook.Owned,
+		Starred:      userBook.Starred,
+		DateAdded:    userBook.DateAdded,
+		LastReadDate: userBook.LastReadDate,
+	}
+	if book := userBook.Book; book != nil {
+		view.Title = book.Title
+		view.Authors = strings.Join(authorNames(book.Contributions), ", ")
+		if book.Slug != "" {
+			view.URL = "https://hardcover.app/books/" + book.Slug
+		}
+	}
+	if userBook.ReadingFormat != nil {
+		view.ReadingFormat = userBook.ReadingFormat.Format
+	}
+	if userBook.EditionID != nil {
+		view.EditionID = *userBook.EditionID
+	}
+	if userBook.Edition != nil {
+		view.Edition = describeUserBookEdition(userBook.Edition)
+	}
+	return view
+}
+
+// printLibraryBookViews writes the library as human-readable text.
+func printLibraryBookViews(w io.Writer, books []libraryBookView) {
+	if len(books) == 0 {
+		printToStdoutf(w, "No books found.\n")
+		return
+	}
+
+	printToStdoutf(w, "Your library (%s)\n\n", pluralize(len(books), "book", "books"))
+	for i := range books {
+		book := &books[i]
+		title := book.Title
+		if book.Authors != "" {
+			title += " by " + book.Authors
+		}
+		if book.Starred {
+			title += " ★"
+		}
+		printToStdoutf(w, "%d. %s\n", i+1, title)
+		printToStdoutf(w, "   Status: %s\n", book.Status)
+		if book.Rating > 0 {
+			printToStdoutf(w, "   Rating: %s/5\n", formatRating(book.Rating))
+		}
+		printOptionalField(w, "Added", book.DateAdded)
+		printOptionalField(w, "Last read", book.LastReadDate)
+		printOptionalField(w, "Format", book.ReadingFormat)
+		printOptionalField(w, "Edition", book.Edition)
+		if book.Owned {
+			printToStdoutf(w, "   Owned: yes\n")
+		}
+		printToStdoutf(w, "   Book ID: %d\n", book.BookID)
+		printSearchSeparator(w)
+	}
+}
+
+// addLibraryListFlags registers the filter and sort flags of the library
+// list command.
+func addLibraryListFlags(cmd *cobra.Command) {
+	cmd.Flags().String("status", "",
+		"only books with these statuses, comma-separated ("+strings.Join(client.UserBookStatusSlugs(), ", ")+")")
+	cmd.Flags().String("rating", "", "only books you rated in this range, e.g. \"4..5\"")
+	cmd.Flags().String("added", "", "only books added in this date range, e.g. \"2024-01-01..2024-06-30\"")
+	cmd.Flags().Bool("owned", false, "only books you own")
+	cmd.Flags().Bool("starred", false, "only starred books")
+	cmd.Flags().String("format", "", "only books read in this format, e.g. \"audiobook\"")
+	cmd.Flags().String("tag", "", "only books with this tag of yours")
+	cmd.Flags().String("sort", defaultLibrarySort, "sort by date_added, rating or title, with an optional :asc or :desc")
+}
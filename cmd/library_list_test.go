@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testLibrary is the shelf used by the library list tests.
+func testLibrary() map[int]map[string]interface{} {
+	return map[int]map[string]interface{}{
+		328491: {
+			"id": 7, "book_id": 328491, "status_id": 3, "rating": 4.5, "owned": true, "starred": true,
+			"date_added": "2024-03-01", "last_read_date": "2024-04-02",
+			"reading_format": map[string]interface{}{"format": "Audiobook"},
+			"book": map[string]interface{}{
+				"id": 328491, "title": "Dune", "slug": "dune",
+				"contributions": []interface{}{map[string]interface{}{"author": map[string]interface{}{"name": "Frank Herbert"}}},
+			},
+			"edition_id": 31, "edition": testEdition,
+		},
+		1: {
+			"id": 8, "book_id": 1, "status_id": 1, "date_added": "2024-05-10",
+			"book": map[string]interface{}{"id": 1, "title": "Children of Dune", "slug": "children-of-dune"},
+		},
+	}
+}
+
+func TestLibraryListCmd_Success(t *testing.T) {
+	library, url := newFakeLibrary(t, testLibrary())
+	cmd, output := newFlagTestCommand(t, url, "text", addLibraryListFlags)
+
+	require.NoError(t, libraryListCmd.RunE(cmd, nil))
+
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "Your library (2 books)")
+	assert.Contains(t, outputStr, "1. Dune by Frank Herbert ★\n   Status: Read\n   Rating: 4.5/5\n   Added: 2024-03-01\n"+
+		"   Last read: 2024-04-02\n   Format: Audiobook\n   Edition: Dune (Mass Market Paperback)\n   Owned: yes\n")
+	assert.Contains(t, outputStr, "2. Children of Dune\n   Status: Want to Read\n   Added: 2024-05-10\n   Book ID: 1\n")
+
+	require.Len(t, library.queries, 1)
+	assert.Equal(t, map[string]interface{}{"_and": []interface{}{
+		map[string]interface{}{"user_id": map[string]interface{}{"_eq": float64(42)}},
+	}}, library.queries[0].Variables["where"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"date_added": "desc_nulls_last"},
+		map[string]interface{}{"id": "asc"},
+	}, library.queries[0].Variables["order_by"])
+}
+
+func TestLibraryListCmd_PartialData(t *testing.T) {
+	library, url := newFakeLibrary(t, testLibrary())
+	library.errors = map[string][]interface{}{"GetUserBooks": {
+		map[string]interface{}{"message": "edition service unavailable", "path": []interface{}{"user_books", 1, "edition"}},
+	}}
+	cmd, output := newFlagTestCommand(t, url, "text", addLibraryListFlags)
+	var stderr bytes.Buffer
+	cmd.SetErr(&stderr)
+
+	require.NoError(t, libraryListCmd.RunE(cmd, nil))
+
+	assert.Contains(t, output.String(), "Your library (2 books)")
+	assert.Equal(t, "Warning: failed to load user_books.1.edition: edition service unavailable\n", stderr.String())
+}
+
+func TestLibraryListCmd_Filters(t *testing.T) {
+	library, url := newFakeLibrary(t, testLibrary())
+	cmd, _ := newFlagTestCommand(t, url, "text", addLibraryListFlags,
+		"--status", "read, dnf", "--rating", "4..", "--added", "2024-01-01..2024-06-30",
+		"--owned", "--starred=false", "--format", "audio", "--tag", "Favorites", "--sort", "title")
+
+	require.NoError(t, libraryListCmd.RunE(cmd, nil))
+
+	require.Len(t, library.queries, 1)
+	where, err := json.Marshal(library.queries[0].Variables["where"])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"_and": [
+		{"user_id": {"_eq": 42}},
+		{"status_id": {"_in": [3, 5]}},
+		{"rating": {"_gte": 4}},
+		{"date_added": {"_gte": "2024-01-01", "_lte": "2024-06-30"}},
+		{"owned": {"_eq": true}},
+		{"starred": {"_eq": false}},
+		{"reading_format": {"format": {"_ilike": "%audio%"}}},
+		{"book": {"taggings": {
+			"user_id": {"_eq": 42},
+			"tag": {"_or": [{"tag": {"_ilike": "Favorites"}}, {"slug": {"_eq": "favorites"}}]}
+		}}}
+	]}`, string(where))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"book": map[string]interface{}{"title": "asc_nulls_last"}},
+		map[string]interface{}{"id": "asc"},
+	}, library.queries[0].Variables["order_by"])
+}
+
+func TestLibraryListCmd_TagMatchesLiterally(t *testing.T) {
+	library, url := newFakeLibrary(t, testLibrary())
+	cmd, _ := newFlagTestCommand(t, url, "text", addLibraryListFlags, "--tag", `sci_fi 100%\`)
+
+	require.NoError(t, libraryListCmd.RunE(cmd, nil))
+
+	where, err := json.Marshal(library.queries[0].Variables["where"])
+	require.NoError(t, err)
+	assert.Contains(t, string(where), `{"tag":{"_ilike":"sci\\_fi 100\\%\\\\"}}`)
+}
+
+func TestLibraryListCmd_CSVOutput(t *testing.T) {
+	_, url := newFakeLibrary(t, testLibrary())
+	cmd, output := newFlagTestCommand(t, url, "csv", addLibraryListFlags)
+
+	require.NoError(t, libraryListCmd.RunE(cmd, nil))
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "user_book_id,book_id,title,authors,status,rating"))
+	assert.Contains(t, lines[1], "Dune,Frank Herbert,Read,4.5,true,true,2024-03-01")
+}
+
+func TestLibraryListCmd_InvalidFilters(t *testing.T) {
+	tests := []struct {
+		args    []string
+		wantErr string
+	}{
+		{args: []string{"--status", "someday"}, wantErr: `unknown status "someday"`},
+		{args: []string{"--rating", "6"}, wantErr: `invalid rating "6" in "6": use a number from 0 to 5`},
+		{args: []string{"--rating", "4..2"}, wantErr: `invalid rating range "4..2"`},
+		{args: []string{"--added", "2024-13-01.."}, wantErr: `invalid date "2024-13-01" in "2024-13-01..": use YYYY-MM-DD`},
+		{args: []string{"--added", "2024-06-01..2024-01-01"}, wantErr: `invalid date range`},
+		{args: []string{"--sort", "pages"}, wantErr: `cannot sort library entries by "pages" (available: date_added, rating, title)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			_, url := newFakeLibrary(t, nil)
+			cmd, _ := newFlagTestCommand(t, url, "text", addLibraryListFlags, tt.args...)
+
+			err := libraryListCmd.RunE(cmd, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,367 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/testutil"
+)
+
+// testEdition is the Dune edition known to the fake library.
+var testEdition = map[string]interface{}{
+	"id":             31,
+	"title":          "Dune",
+	"isbn_10":        "0441013597",
+	"isbn_13":        "9780441013593",
+	"edition_format": "Mass Market Paperback",
+	"book":           map[string]interface{}{"id": 328491, "title": "Dune", "slug": "dune"},
+}
+
+// operationPattern extracts the operation name from a GraphQL document.
+var operationPattern = regexp.MustCompile(`(?:query|mutation) (\w+)`)
+
+// fakeLibrary is an in-memory stand-in for the API's shelf queries and
+// mutations, serving testBookDetail as the only book.
+type fakeLibrary struct {
+	t         *testing.T
+	mu        sync.Mutex
+	nextID    int
+	userBooks map[int]map[string]interface{}
+	journals  []map[string]interface{}
+	goals     []map[string]interface{}
+	lists     []map[string]interface{}
+	// errors are sent alongside the data of the named operations.
+	errors map[string][]interface{}
+	// calls counts the requests made for each operation.
+	calls     map[string]int
+	queries   []client.GraphQLRequest
+	mutations []client.GraphQLRequest
+}
+
+// newFakeLibrary starts a fake library server holding the given shelf
+// entries, keyed by book ID.
+func newFakeLibrary(t *testing.T, userBooks map[int]map[string]interface{}) (*fakeLibrary, string) {
+	t.Helper()
+
+	library := &fakeLibrary{t: t, nextID: 500, userBooks: userBooks, calls: make(map[string]int)}
+	if library.userBooks == nil {
+		library.userBooks = make(map[int]map[string]interface{})
+	}
+	server := testutil.CreateTestServerWithHandler(library.serveHTTP)
+	t.Cleanup(server.Close)
+	return library, server.URL
+}
+
+// serveHTTP answers one GraphQL request.
+func (l *fakeLibrary) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var req client.GraphQLRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		l.t.Errorf("Failed to decode request body: %v", err)
+		return
+	}
+
+	l.mu.Lock()
+	data := l.respond(&req)
+	l.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	response := map[string]interface{}{"data": data}
+	if match := operationPattern.FindStringSubmatch(req.Query); match != nil && l.errors[match[1]] != nil {
+		response["errors"] = l.errors[match[1]]
+	}
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		l.t.Errorf("Failed to encode response: %v", err)
+	}
+}
+
+// respond builds the data of the response to req.
+func (l *fakeLibrary) respond(req *client.GraphQLRequest) interface{} {
+	match := operationPattern.FindStringSubmatch(req.Query)
+	if match == nil {
+		l.t.Errorf("Unexpected query: %s", req.Query)
+		return nil
+	}
+
+	l.calls[match[1]]++
+	switch match[1] {
+	case "GetBook":
+		return map[string]interface{}{"books": []interface{}{testBookDetail}}
+	case "GetBookByISBN":
+		return map[string]interface{}{"editions": []interface{}{map[string]interface{}{"book": testBookDetail}}}
+	case "GetEditionsByISBN":
+		return map[string]interface{}{"editions": []interface{}{testEdition}}
+	case "GetEdition":
+		return l.edition(req.Variables)
+	case "GetCurrentUserID":
+		return map[string]interface{}{"me": []interface{}{map[string]interface{}{"id": 42}}}
+	case "GetUserBooks":
+		l.queries = append(l.queries, *req)
+		return map[string]interface{}{"user_books": l.page(req.Variables)}
+	case "GetMyUserBooks":
+		var entries []interface{}
+		for _, id := range req.Variables["book_ids"].([]interface{}) {
+			if entry, ok := l.userBooks[int(id.(float64))]; ok {
+				entries = append(entries, entry)
+			}
+		}
+		return map[string]interface{}{"me": []interface{}{map[string]interface{}{"user_books": entries}}}
+	case "GetReadingJournals":
+		l.queries = append(l.queries, *req)
+		return map[string]interface{}{"reading_journals": l.journalPage(req.Variables)}
+	case "GetReadingJournalsSummary":
+		return map[string]interface{}{"reading_journals_summary": l.journalSummary()}
+	case "GetList":
+		return map[string]interface{}{"lists": l.findList(req.Variables)}
+	case "GetLists":
+		return map[string]interface{}{"lists": l.userLists(req.Variables)}
+	case "GetGoals":
+		l.queries = append(l.queries, *req)
+		return map[string]interface{}{"goals": l.findGoals(req.Variables)}
+	case "Search":
+		l.queries = append(l.queries, *req)
+		return map[string]interface{}{"search": map[string]interface{}{"results": map[string]interface{}{
+			"found": 1,
+			"hits": []interface{}{map[string]interface{}{"document": map[string]interface{}{
+				"id": "2", "title": "Hyperion", "author_names": []string{"Dan Simmons"},
+			}}},
+		}}}
+	default:
+		l.mutations = append(l.mutations, *req)
+		return l.mutate(match[1], req.Variables)
+	}
+}
+
+// page returns the shelf entries in the page selected by limit and offset,
+// ordered by entry ID.
+func (l *fakeLibrary) page(variables map[string]interface{}) []interface{} {
+	entries := make([]map[string]interface{}, 0, len(l.userBooks))
+	for _, entry := range l.userBooks {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i]["id"].(int) < entries[j]["id"].(int) })
+
+	offset, limit := int(variables["offset"].(float64)), int(variables["limit"].(float64))
+	page := make([]interface{}, 0, limit)
+	for i := offset; i < len(entries) && i < offset+limit; i++ {
+		page = append(page, entries[i])
+	}
+	return page
+}
+
+// edition answers GetEdition: 31 is a Dune edition, 99 an edition of
+// another book and anything else is unknown.
+func (l *fakeLibrary) edition(variables map[string]interface{}) interface{} {
+	id := variables["where"].(map[string]interface{})["id"].(map[string]interface{})["_eq"]
+	switch id {
+	case float64(31):
+		return map[string]interface{}{"editions": []interface{}{testEdition}}
+	case float64(99):
+		return map[string]interface{}{"editions": []interface{}{map[string]interface{}{
+			"id": 99, "title": "Children of Dune", "book": map[string]interface{}{"id": 1, "title": "Children of Dune"},
+		}}}
+	default:
+		return map[string]interface{}{"editions": []interface{}{}}
+	}
+}
+
+// mutate applies a shelf mutation.
+func (l *fakeLibrary) mutate(operation string, variables map[string]interface{}) interface{} {
+	switch operation {
+	case "InsertUserBook":
+		object := variables["object"].(map[string]interface{})
+		l.nextID++
+		entry := map[string]interface{}{"id": l.nextID}
+		l.apply(entry, object)
+		l.userBooks[int(object["book_id"].(float64))] = entry
+		return map[string]interface{}{"insert_user_book": map[string]interface{}{"id": l.nextID, "user_book": entry}}
+	case "UpdateUserBook":
+		entry := l.entryByID(variables["id"])
+		l.apply(entry, variables["object"].(map[string]interface{}))
+		return map[string]interface{}{"update_user_book": map[string]interface{}{"id": entry["id"], "user_book": entry}}
+	case "DeleteUserBook":
+		entry := l.entryByID(variables["id"])
+		delete(l.userBooks, entry["book_id"].(int))
+		return map[string]interface{}{"delete_user_book": map[string]interface{}{"id": entry["id"], "book_id": entry["book_id"]}}
+	case "InsertUserBookRead":
+		entry := l.entryByID(variables["user_book_id"])
+		l.nextID++
+		read := map[string]interface{}{"id": l.nextID, "user_book_id": entry["id"]}
+		l.apply(read, variables["user_book_read"].(map[string]interface{}))
+		reads, _ := entry["user_book_reads"].([]interface{})
+		entry["user_book_reads"] = append(reads, read)
+		return map[string]interface{}{"insert_user_book_read": map[string]interface{}{"id": l.nextID, "user_book_read": read}}
+	case "UpdateUserBookRead":
+		read := l.readByID(variables["id"])
+		l.apply(read, variables["object"].(map[string]interface{}))
+		return map[string]interface{}{"update_user_book_read": map[string]interface{}{"id": read["id"], "user_book_read": read}}
+	case "InsertReadingJournal", "UpdateReadingJournal", "DeleteReadingJournal":
+		return l.mutateJournal(operation, variables)
+	case "InsertGoal", "UpdateGoal", "UpdateGoalProgress":
+		return l.mutateGoal(operation, variables)
+	case "InsertList", "UpdateList", "DeleteList", "InsertListBook", "DeleteListBook", "UpdateListBookPosition":
+		return l.mutateList(operation, variables)
+	default:
+		l.t.Errorf("Unexpected operation: %s", operation)
+		return nil
+	}
+}
+
+// apply copies the fields of a mutation object into a shelf entry.
+func (l *fakeLibrary) apply(entry, object map[string]interface{}) {
+	for key, value := range object {
+		entry[key] = value
+	}
+	if id, ok := object["book_id"].(float64); ok {
+		entry["book_id"] = int(id)
+	}
+	if object["edition_id"] == float64(31) {
+		entry["edition"] = testEdition
+	}
+}
+
+// readByID finds the read with the given ID.
+func (l *fakeLibrary) readByID(id interface{}) map[string]interface{} {
+	for _, entry := range l.userBooks {
+		reads, _ := entry["user_book_reads"].([]interface{})
+		for _, read := range reads {
+			if read := read.(map[string]interface{}); float64(read["id"].(int)) == id {
+				return read
+			}
+		}
+	}
+	l.t.Errorf("No read with ID %v", id)
+	return map[string]interface{}{}
+}
+
+// entryByID finds the shelf entry with the given ID.
+func (l *fakeLibrary) entryByID(id interface{}) map[string]interface{} {
+	for _, entry := range l.userBooks {
+		if float64(entry["id"].(int)) == id {
+			return entry
+		}
+	}
+	l.t.Errorf("No shelf entry with ID %v", id)
+	return map[string]interface{}{}
+}
+
+func TestLibraryAddCmd_AddsAsWantToRead(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	cmd, output := newFlagTestCommand(t, url, "text", addLibraryAddFlags)
+
+	require.NoError(t, libraryAddCmd.RunE(cmd, []string{"dune"}))
+
+	assert.Equal(t, "Added \"Dune\" to your library as Want to Read.\n", output.String())
+	require.Len(t, library.mutations, 1)
+	assert.Equal(t, map[string]interface{}{"book_id": float64(328491), "status_id": float64(1)},
+		library.mutations[0].Variables["object"])
+}
+
+func TestLibraryAddCmd_IsIdempotent(t *testing.T) {
+	library, url := newFakeLibrary(t, map[int]map[string]interface{}{
+		328491: {"id": 7, "book_id": 328491, "status_id": 3},
+	})
+	cmd, output := newFlagTestCommand(t, url, "text", addLibraryAddFlags, "--status", "read")
+
+	require.NoError(t, libraryAddCmd.RunE(cmd, []string{"328491"}))
+
+	assert.Equal(t, "\"Dune\" is already in your library as Read.\n", output.String())
+	assert.Empty(t, library.mutations)
+}
+
+func TestLibraryAddCmd_ByISBNChoosesEdition(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	cmd, output := newFlagTestCommand(t, url, "text", addLibraryAddFlags, "--status", "currently-reading")
+
+	require.NoError(t, libraryAddCmd.RunE(cmd, []string{"9780441013593"}))
+
+	assert.Equal(t, "Added \"Dune\" to your library as Currently Reading.\n"+
+		"  Edition: Dune (Mass Market Paperback) (ID 31)\n", output.String())
+	require.Len(t, library.mutations, 1)
+	assert.Equal(t, map[string]interface{}{"book_id": float64(328491), "status_id": float64(2), "edition_id": float64(31)},
+		library.mutations[0].Variables["object"])
+}
+
+func TestLibraryAddCmd_InvalidStatus(t *testing.T) {
+	cmd, _ := newFlagTestCommand(t, "http://unused.invalid", "text", addLibraryAddFlags, "--status", "someday")
+
+	err := libraryAddCmd.RunE(cmd, []string{"dune"})
+	require.Error(t, err)
+	assert.Equal(t, `unknown status "someday" (available: want-to-read, currently-reading, read, paused, did-not-finish, ignored)`,
+		err.Error())
+}
+
+func TestLibraryStatusCmd_ChangesStatus(t *testing.T) {
+	library, url := newFakeLibrary(t, map[int]map[string]interface{}{
+		328491: {"id": 7, "book_id": 328491, "status_id": 1},
+	})
+	cmd, output := newBookTestCommand(url, "json")
+
+	require.NoError(t, libraryStatusCmd.RunE(cmd, []string{"dune", "dnf"}))
+
+	var view libraryEntryView
+	require.NoError(t, json.Unmarshal(output.Bytes(), &view))
+	assert.Equal(t, libraryEntryView{
+		Action: "updated", UserBookID: 7, BookID: 328491, Title: "Dune",
+		Status: "Did Not Finish", PreviousStatus: "Want to Read",
+	}, view)
+	require.Len(t, library.mutations, 1)
+	assert.Equal(t, float64(7), library.mutations[0].Variables["id"])
+	assert.Equal(t, map[string]interface{}{"status_id": float64(5)}, library.mutations[0].Variables["object"])
+}
+
+func TestLibraryStatusCmd_ShowAndNotShelved(t *testing.T) {
+	_, url := newFakeLibrary(t, map[int]map[string]interface{}{
+		328491: {"id": 7, "book_id": 328491, "status_id": 2},
+	})
+	cmd, output := newBookTestCommand(url, "text")
+	require.NoError(t, libraryStatusCmd.RunE(cmd, []string{"dune"}))
+	assert.Equal(t, "\"Dune\" is in your library as Currently Reading.\n", output.String())
+
+	_, url = newFakeLibrary(t, nil)
+	cmd, _ = newBookTestCommand(url, "text")
+	err := libraryStatusCmd.RunE(cmd, []string{"dune", "read"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"Dune" is not in your library`)
+}
+
+func TestLibraryEditionCmd(t *testing.T) {
+	library, url := newFakeLibrary(t, map[int]map[string]interface{}{
+		328491: {"id": 7, "book_id": 328491, "status_id": 2},
+	})
+
+	cmd, output := newBookTestCommand(url, "text")
+	require.NoError(t, libraryEditionCmd.RunE(cmd, []string{"dune", "31"}))
+	assert.Equal(t, "Updated \"Dune\".\n  Edition: Dune (Mass Market Paperback) (ID 31)\n", output.String())
+	require.Len(t, library.mutations, 1)
+	assert.Equal(t, map[string]interface{}{"edition_id": float64(31)}, library.mutations[0].Variables["object"])
+
+	cmd, _ = newBookTestCommand(url, "text")
+	err := libraryEditionCmd.RunE(cmd, []string{"dune", "99"})
+	require.Error(t, err)
+	assert.Equal(t, `edition "99" of "Dune" not found`, err.Error())
+}
+
+func TestLibraryRemoveCmd_IsIdempotent(t *testing.T) {
+	library, url := newFakeLibrary(t, map[int]map[string]interface{}{
+		328491: {"id": 7, "book_id": 328491, "status_id": 3},
+	})
+
+	cmd, output := newBookTestCommand(url, "text")
+	require.NoError(t, libraryRemoveCmd.RunE(cmd, []string{"dune"}))
+	assert.Equal(t, "Removed \"Dune\" from your library.\n", output.String())
+
+	cmd, output = newBookTestCommand(url, "text")
+	require.NoError(t, libraryRemoveCmd.RunE(cmd, []string{"dune"}))
+	assert.Equal(t, "\"Dune\" is not in your library.\n", output.String())
+
+	require.Len(t, library.mutations, 1)
+	assert.Contains(t, library.mutations[0].Query, "mutation DeleteUserBook(")
+}
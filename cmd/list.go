@@ -0,0 +1,624 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// listCmd represents the list command.
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Create and curate book lists",
+	Long: `Commands for your Hardcover book lists.
+
+Lists are identified by ID or, for your own lists, by URL slug, e.g.
+"favourite-sf" in https://hardcover.app/@you/lists/favourite-sf. Books are
+identified by Hardcover ID, URL slug or ISBN.
+
+Available subcommands:
+  create     Create a list
+  show       Show a list and its books
+  add        Add a book to a list
+  remove     Remove a book from a list
+  move       Move a book to a position on a ranked list
+  rename     Rename a list
+  privacy    Change who can see a list
+  delete     Delete a list`,
+}
+
+// listCreateCmd represents the list create command.
+var listCreateCmd = &cobra.Command{
+	Use:   "create <name...>",
+	Short: "Create a list",
+	Long: `Create a book list. Ranked lists keep their books in the order you give
+them with "hardcover list move".
+
+Example:
+  hardcover list create Favourite SF --ranked
+  hardcover list create "Books to gift" --description "For birthdays" --privacy private`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		input, err := listInput(cmd, strings.Join(args, " "))
+		if err != nil {
+			return err
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		list, err := gqlClient.AddList(context.Background(), input)
+		if err != nil {
+			return fmt.Errorf("failed to create list: %w", err)
+		}
+		if list.Name == "" {
+			// The API returned only the new list's ID.
+			list.Name, list.Ranked, list.PrivacySettingID = input.Name, *input.Ranked, input.PrivacySettingID
+		}
+
+		view := newListView(list)
+		view.Action = libraryActionAdded
+		return renderListChange(cmd, view, fmt.Sprintf("Created list %q (list %d).", view.Name, view.ID))
+	},
+}
+
+// listShowCmd represents the list show command.
+var listShowCmd = &cobra.Command{
+	Use:   "show <list>",
+	Short: "Show a list and its books",
+	Long: `Show a list and its books in list order. Any list can be shown by ID;
+slugs refer to your own lists.
+
+Example:
+  hardcover list show favourite-sf
+  hardcover list show 1234 -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		list, err := resolveList(context.Background(), gqlClient, 0, args[0])
+		if err != nil {
+			return err
+		}
+
+		view := newListView(list)
+		return render(cmd, view, func(w io.Writer) {
+			printListView(w, view)
+		})
+	},
+}
+
+// listAddCmd represents the list add command.
+var listAddCmd = &cobra.Command{
+	Use:   "add <list> <book>",
+	Short: "Add a book to a list",
+	Long: `Add a book to one of your lists, optionally with the reason it is there.
+On a ranked list the book goes to the end; use "hardcover list move" to
+rank it. Adding a book that is already on the list changes nothing.
+
+Example:
+  hardcover list add favourite-sf dune
+  hardcover list add favourite-sf 9780441013593 --reason "The one that started it all"`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var reason string
+		if err := readStringFlags(cmd, map[string]*string{"reason": &reason}); err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		gqlClient, list, err := findOwnList(ctx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+		book, err := resolveLibraryBook(ctx, gqlClient, args[1])
+		if err != nil {
+			return err
+		}
+
+		view := &listBookChangeView{ListID: list.ID, List: list.Name, BookID: book.ID, Title: book.Title}
+		if existing := list.ListBookFor(book.ID); existing != nil {
+			view.Action, view.Position = libraryActionUnchanged, existing.Position
+			return renderListBookChange(cmd, view, fmt.Sprintf("%q is already on %q.", book.Title, list.Name))
+		}
+
+		input := &client.ListBookInput{ListID: list.ID, BookID: book.ID}
+		if list.Ranked {
+			input.Position = len(list.ListBooks) + 1
+		}
+		if reason = strings.TrimSpace(reason); reason != "" {
+			input.Reason = &reason
+		}
+		added, err := gqlClient.AddListBook(ctx, input)
+		if err != nil {
+			return fmt.Errorf("failed to add book to list: %w", err)
+		}
+
+		view.Action, view.Position = libraryActionAdded, added.Position
+		message := fmt.Sprintf("Added %q to %q.", book.Title, list.Name)
+		if list.Ranked {
+			message = fmt.Sprintf("Added %q to %q at position %d.", book.Title, list.Name, input.Position)
+		}
+		return renderListBookChange(cmd, view, message)
+	},
+}
+
+// listRemoveCmd represents the list remove command.
+var listRemoveCmd = &cobra.Command{
+	Use:   "remove <list> <book>",
+	Short: "Remove a book from a list",
+	Long: `Remove a book from one of your lists. On a ranked list the books after it
+move up a place. Removing a book that is not on the list changes nothing.
+
+Example:
+  hardcover list remove favourite-sf dune`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		gqlClient, list, err := findOwnList(ctx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+		book, err := resolveLibraryBook(ctx, gqlClient, args[1])
+		if err != nil {
+			return err
+		}
+
+		view := &listBookChangeView{ListID: list.ID, List: list.Name, BookID: book.ID, Title: book.Title}
+		existing := list.ListBookFor(book.ID)
+		if existing == nil {
+			view.Action = libraryActionNotShelved
+			return renderListBookChange(cmd, view, fmt.Sprintf("%q is not on %q.", book.Title, list.Name))
+		}
+		if err := gqlClient.RemoveListBook(ctx, existing.ID); err != nil {
+			return fmt.Errorf("failed to remove book from list: %w", err)
+		}
+		if list.Ranked {
+			if err := rankListBooks(ctx, gqlClient, withoutListBook(list.ListBooks, existing.ID)); err != nil {
+				return err
+			}
+		}
+
+		view.Action, view.Position = libraryActionRemoved, existing.Position
+		return renderListBookChange(cmd, view, fmt.Sprintf("Removed %q from %q.", book.Title, list.Name))
+	},
+}
+
+// listMoveCmd represents the list move command.
+var listMoveCmd = &cobra.Command{
+	Use:   "move <list> <book> <position>",
+	Short: "Move a book to a position on a ranked list",
+	Long: `Move a book to a position on one of your ranked lists, counting from 1.
+The books between its old and new positions shift to make room.
+
+Example:
+  hardcover list move favourite-sf dune 1`,
+	Args: cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		position, err := strconv.Atoi(args[2])
+		if err != nil || position <= 0 {
+			return fmt.Errorf("invalid position %q: use a whole number from 1", args[2])
+		}
+
+		ctx := context.Background()
+		gqlClient, list, err := findOwnList(ctx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+		if !list.Ranked {
+			return fmt.Errorf("%q is not a ranked list; only ranked lists keep positions", list.Name)
+		}
+		book, err := resolveLibraryBook(ctx, gqlClient, args[1])
+		if err != nil {
+			return err
+		}
+		existing := list.ListBookFor(book.ID)
+		if existing == nil {
+			return fmt.Errorf("%q is not on %q", book.Title, list.Name)
+		}
+		if position > len(list.ListBooks) {
+			return fmt.Errorf("invalid position %d: %q has %d books", position, list.Name, len(list.ListBooks))
+		}
+
+		books := withoutListBook(list.ListBooks, existing.ID)
+		books = append(books[:position-1], append([]client.ListBook{*existing}, books[position-1:]...)...)
+		if err := rankListBooks(ctx, gqlClient, books); err != nil {
+			return err
+		}
+
+		view := &listBookChangeView{
+			Action: libraryActionUpdated, ListID: list.ID, List: list.Name, BookID: book.ID, Title: book.Title, Position: position,
+		}
+		return renderListBookChange(cmd, view, fmt.Sprintf("Moved %q to position %d on %q.", book.Title, position, list.Name))
+	},
+}
+
+// listRenameCmd represents the list rename command.
+var listRenameCmd = &cobra.Command{
+	Use:   "rename <list> <name...>",
+	Short: "Rename a list",
+	Long: `Rename one of your lists. Hardcover may give the list a new slug.
+
+Example:
+  hardcover list rename favourite-sf Favourite Science Fiction`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := strings.TrimSpace(strings.Join(args[1:], " "))
+		if name == "" {
+			return errors.New("a list name is required")
+		}
+
+		ctx := context.Background()
+		gqlClient, list, err := findOwnList(ctx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+		if name == list.Name {
+			view := newListView(list)
+			view.Action = libraryActionUnchanged
+			return renderListChange(cmd, view, fmt.Sprintf("List %d is already called %q.", list.ID, name))
+		}
+
+		view, err := updateList(ctx, gqlClient, list, &client.ListInput{Name: name})
+		if err != nil {
+			return err
+		}
+		view.Name = name
+		return renderListChange(cmd, view, fmt.Sprintf("Renamed %q to %q.", list.Name, name))
+	},
+}
+
+// listPrivacyCmd represents the list privacy command.
+var listPrivacyCmd = &cobra.Command{
+	Use:   "privacy <list> <public|followers|private>",
+	Short: "Change who can see a list",
+	Long: `Change who can see one of your lists: everyone (public), the people who
+follow you (followers) or only you (private).
+
+Example:
+  hardcover list privacy favourite-sf private`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		privacy, err := client.ParsePrivacySetting(args[1])
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		gqlClient, list, err := findOwnList(ctx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+		if privacy == list.PrivacySettingID {
+			view := newListView(list)
+			view.Action = libraryActionUnchanged
+			return renderListChange(cmd, view, fmt.Sprintf("%q is already %s.", list.Name, privacy))
+		}
+
+		view, err := updateList(ctx, gqlClient, list, &client.ListInput{PrivacySettingID: privacy})
+		if err != nil {
+			return err
+		}
+		view.Privacy = privacy.String()
+		return renderListChange(cmd, view, fmt.Sprintf("%q is now %s.", list.Name, privacy))
+	},
+}
+
+// listDeleteCmd represents the list delete command.
+var listDeleteCmd = &cobra.Command{
+	Use:   "delete <list>",
+	Short: "Delete a list",
+	Long: `Delete one of your lists. The books stay in your library.
+
+Example:
+  hardcover list delete favourite-sf`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		gqlClient, list, err := findOwnList(ctx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+		if err := gqlClient.DeleteList(ctx, list.ID); err != nil {
+			return fmt.Errorf("failed to delete list: %w", err)
+		}
+
+		view := newListView(list)
+		view.Action = libraryActionRemoved
+		return renderListChange(cmd, view, fmt.Sprintf("Deleted list %q (%s).", list.Name,
+			pluralize(len(list.ListBooks), "book", "books")))
+	},
+}
+
+// listInput builds the list to create from the list create flags and name.
+func listInput(cmd *cobra.Command, name string) (*client.ListInput, error) {
+	input := &client.ListInput{Name: strings.TrimSpace(name)}
+	if input.Name == "" {
+		return nil, errors.New("a list name is required")
+	}
+
+	var description, privacy string
+	if err := readStringFlags(cmd, map[string]*string{"description": &description, "privacy": &privacy}); err != nil {
+		return nil, err
+	}
+	var ranked bool
+	if err := readBoolFlags(cmd, map[string]*bool{"ranked": &ranked}); err != nil {
+		return nil, err
+	}
+	var err error
+	if input.PrivacySettingID, err = client.ParsePrivacySetting(privacy); err != nil {
+		return nil, err
+	}
+	input.Ranked = &ranked
+	if description = strings.TrimSpace(description); description != "" {
+		input.Description = &description
+	}
+	return input, nil
+}
+
+// resolveList looks a list up by ID or, among the current user's lists, by
+// slug, reporting unknown lists by their identifier. userID is the current
+// user's ID, or zero to fetch it when it is needed.
+func resolveList(ctx context.Context, c *client.Client, userID int, identifier string) (*client.List, error) {
+	var list *client.List
+	var err error
+	if id, convErr := strconv.Atoi(identifier); convErr == nil {
+		list, err = c.GetList(ctx, id)
+	} else {
+		if userID == 0 {
+			if userID, err = c.CurrentUserID(ctx); err != nil {
+				return nil, fmt.Errorf("failed to get user profile: %w", err)
+			}
+		}
+		list, err = c.GetListBySlug(ctx, userID, identifier)
+	}
+	if errors.Is(err, client.ErrNotFound) {
+		return nil, fmt.Errorf("list %q %w", identifier, client.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get list: %w", err)
+	}
+	return list, nil
+}
+
+// findOwnList looks up one of the current user's lists by the ID or slug
+// given on the command line.
+func findOwnList(ctx context.Context, cmd *cobra.Command, identifier string) (*client.Client, *client.List, error) {
+	gqlClient, err := newAuthenticatedClient(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userID, err := gqlClient.CurrentUserID(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get user profile: %w", err)
+	}
+	list, err := resolveList(ctx, gqlClient, userID, identifier)
+	if err != nil {
+		return nil, nil, err
+	}
+	if list.UserID != userID {
+		return nil, nil, fmt.Errorf("list %q belongs to another user", identifier)
+	}
+	return gqlClient, list, nil
+}
+
+// updateList applies input to list and describes the updated list.
+func updateList(ctx context.Context, c *client.Client, list *client.List, input *client.ListInput) (*listView, error) {
+	updated, err := c.UpdateList(ctx, list.ID, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update list: %w", err)
+	}
+	if updated.Name == "" {
+		// The API returned only the list's ID.
+		updated = list
+	}
+	view := newListView(updated)
+	view.Action = libraryActionUpdated
+	return view, nil
+}
+
+// withoutListBook returns a copy of books without the one with the given
+// list_books ID.
+func withoutListBook(books []client.ListBook, id int) []client.ListBook {
+	remaining := make([]client.ListBook, 0, len(books))
+	for i := range books {
+		if books[i].ID != id {
+			remaining = append(remaining, books[i])
+		}
+	}
+	return remaining
+}
+
+// rankListBooks numbers books from 1 in the given order, only updating the
+// books whose position changes.
+func rankListBooks(ctx context.Context, c *client.Client, books []client.ListBook) error {
+	for i := range books {
+		if books[i].Position == i+1 {
+			continue
+		}
+		if err := c.SetListBookPosition(ctx, books[i].ID, i+1); err != nil {
+			return fmt.Errorf("failed to reorder list: %w", err)
+		}
+	}
+	return nil
+}
+
+// listView is the structured form of a list in the list commands' output.
+// Action is set by the commands that change a list.
+type listView struct {
+	Action      string         `json:"action,omitempty"`
+	ID          int            `json:"id"`
+	Name        string         `json:"name"`
+	Slug        string         `json:"slug"`
+	Description string         `json:"description"`
+	Ranked      bool           `json:"ranked"`
+	Privacy     string         `json:"privacy"`
+	Owner       string         `json:"owner"`
+	BooksCount  int            `json:"books_count"`
+	URL         string         `json:"url"`
+	Books       []listBookView `json:"books"`
+}
+
+// listBookView is a book in the list show command's output. Position is
+// only set on ranked lists.
+type listBookView struct {
+	Position    int    `json:"position"`
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	Authors     string `json:"authors"`
+	ReleaseYear int    `json:"release_year"`
+	Reason      string `json:"reason"`
+	URL         string `json:"url"`
+}
+
+// newListView converts a client list into its output representation.
+func newListView(list *client.List) *listView {
+	view := &listView{
+		ID:          list.ID,
+		Name:        list.Name,
+		Slug:        list.Slug,
+		Description: list.Description,
+		Ranked:      list.Ranked,
+		BooksCount:  max(list.BooksCount, len(list.ListBooks)),
+		URL:         list.URL(),
+		Books:       make([]listBookView, 0, len(list.ListBooks)),
+	}
+	if list.PrivacySettingID != 0 {
+		view.Privacy = list.PrivacySettingID.String()
+	}
+	if list.User != nil {
+		view.Owner = list.User.Username
+	}
+	for i := range list.ListBooks {
+		listBook := &list.ListBooks[i]
+		book := listBookView{ID: listBook.BookID, Reason: listBook.Reason}
+		if list.Ranked {
+			book.Position = i + 1
+		}
+		if summary := listBook.Book; summary != nil {
+			book.Title = summary.Title
+			book.Authors = strings.Join(authorNames(summary.Contributions), ", ")
+			book.ReleaseYear = summary.ReleaseYear
+			if summary.Slug != "" {
+				book.URL = "https://hardcover.app/books/" + summary.Slug
+			}
+		}
+		view.Books = append(view.Books, book)
+	}
+	return view
+}
+
+// printListView writes a list and its books as human-readable text.
+func printListView(w io.Writer, list *listView) {
+	var traits []string
+	if list.Ranked {
+		traits = append(traits, "ranked")
+	}
+	if list.Privacy != "" {
+		traits = append(traits, list.Privacy)
+	}
+	title := list.Name
+	if len(traits) > 0 {
+		title += " (" + strings.Join(traits, ", ") + ")"
+	}
+	printToStdoutf(w, "%s\n", title)
+	if list.Owner != "" {
+		printOptionalField(w, "By", "@"+list.Owner)
+	}
+	printOptionalField(w, "Description", list.Description)
+	printOptionalField(w, "List ID", strconv.Itoa(list.ID))
+	printOptionalField(w, "URL", list.URL)
+	printToStdoutf(w, "\n")
+
+	if len(list.Books) == 0 {
+		printToStdoutf(w, "No books on this list yet.\n")
+		return
+	}
+	for i := range list.Books {
+		book := &list.Books[i]
+		title := book.Title
+		if book.Authors != "" {
+			title += " by " + book.Authors
+		}
+		if book.ReleaseYear > 0 {
+			title += fmt.Sprintf(" (%d)", book.ReleaseYear)
+		}
+		if list.Ranked {
+			printToStdoutf(w, "%d. %s\n", book.Position, title)
+		} else {
+			printToStdoutf(w, "- %s\n", title)
+		}
+		printOptionalField(w, "Reason", book.Reason)
+	}
+}
+
+// renderListChange writes the outcome of a change to a list, described by
+// message in text output.
+func renderListChange(cmd *cobra.Command, view *listView, message string) error {
+	return render(cmd, view, func(w io.Writer) {
+		printToStdoutf(w, "%s\n", message)
+		if view.Action != libraryActionRemoved {
+			printOptionalField(w, "URL", view.URL)
+		}
+	})
+}
+
+// listBookChangeView is the structured form of the outcome of a change to
+// the books on a list.
+type listBookChangeView struct {
+	Action   string `json:"action"`
+	ListID   int    `json:"list_id"`
+	List     string `json:"list"`
+	BookID   int    `json:"book_id"`
+	Title    string `json:"title"`
+	Position int    `json:"position"`
+}
+
+// renderListBookChange writes the outcome of a change to the books on a
+// list, described by message in text output.
+func renderListBookChange(cmd *cobra.Command, view *listBookChangeView, message string) error {
+	return render(cmd, view, func(w io.Writer) {
+		printToStdoutf(w, "%s\n", message)
+	})
+}
+
+// addListCreateFlags registers the flags of the list create command.
+func addListCreateFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("ranked", false, "keep the books in a ranked order")
+	cmd.Flags().String("description", "", "what the list is about")
+	cmd.Flags().String("privacy", client.PrivacyPublic.String(),
+		"who can see the list ("+strings.Join(client.PrivacySettingNames(), ", ")+")")
+}
+
+// addListAddFlags registers the flags of the list add command.
+func addListAddFlags(cmd *cobra.Command) {
+	cmd.Flags().String("reason", "", "why the book is on the list")
+}
+
+// setupListCommands registers the list commands with the root command.
+func setupListCommands() {
+	addListCreateFlags(listCreateCmd)
+	listCmd.AddCommand(listCreateCmd)
+	listCmd.AddCommand(listShowCmd)
+	addListAddFlags(listAddCmd)
+	listCmd.AddCommand(listAddCmd)
+	listCmd.AddCommand(listRemoveCmd)
+	listCmd.AddCommand(listMoveCmd)
+	listCmd.AddCommand(listRenameCmd)
+	listCmd.AddCommand(listPrivacyCmd)
+	listCmd.AddCommand(listDeleteCmd)
+	rootCmd.AddCommand(listCmd)
+}
@@ -0,0 +1,256 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testLists are a ranked list of the current user's with Dune first of
+// three, and another user's list.
+func testLists() []map[string]interface{} {
+	listBook := func(id, bookID, position int, title string) map[string]interface{} {
+		return map[string]interface{}{
+			"id": id, "list_id": 77, "book_id": bookID, "position": position,
+			"book": map[string]interface{}{"id": bookID, "title": title, "slug": "", "release_year": 1960 + bookID},
+		}
+	}
+	return []map[string]interface{}{
+		{
+			"id": 77, "name": "Favourite SF", "slug": "favourite-sf", "description": "The best of the genre",
+			"ranked": true, "privacy_setting_id": 1, "books_count": 3, "user_id": 42,
+			"user": map[string]interface{}{"username": "alice"},
+			"list_books": []interface{}{
+				map[string]interface{}{
+					"id": 701, "list_id": 77, "book_id": 328491, "position": 1, "reason": "The one that started it all",
+					"book": testBookDetail,
+				},
+				listBook(702, 2, 2, "Hyperion"),
+				listBook(703, 3, 3, "Foundation"),
+			},
+		},
+		{"id": 88, "name": "Someone else's", "slug": "favourite-sf", "user_id": 7, "list_books": []interface{}{}},
+	}
+}
+
+// findList answers GetList, matching by ID or by slug and user.
+func (l *fakeLibrary) findList(variables map[string]interface{}) []interface{} {
+	where := variables["where"].(map[string]interface{})
+	for _, list := range l.lists {
+		if id, ok := where["id"].(map[string]interface{}); ok && float64(list["id"].(int)) == id["_eq"] {
+			return []interface{}{list}
+		}
+		slug, ok := where["slug"].(map[string]interface{})
+		if ok && list["slug"] == slug["_eq"] && float64(list["user_id"].(int)) == where["user_id"].(map[string]interface{})["_eq"] {
+			return []interface{}{list}
+		}
+	}
+	return []interface{}{}
+}
+
+// userLists answers GetLists with every list of the selected user on the
+// first page.
+func (l *fakeLibrary) userLists(variables map[string]interface{}) []interface{} {
+	lists := []interface{}{}
+	if variables["offset"].(float64) > 0 {
+		return lists
+	}
+	userID := variables["where"].(map[string]interface{})["user_id"].(map[string]interface{})["_eq"]
+	for _, list := range l.lists {
+		if float64(list["user_id"].(int)) == userID {
+			lists = append(lists, list)
+		}
+	}
+	return lists
+}
+
+// mutateList answers a list mutation. Only insert_list keeps the new list.
+func (l *fakeLibrary) mutateList(operation string, variables map[string]interface{}) interface{} {
+	switch operation {
+	case "InsertList":
+		l.nextID++
+		list := map[string]interface{}{"id": l.nextID, "slug": "favourite-sf-2", "user": map[string]interface{}{"username": "alice"}}
+		l.apply(list, variables["object"].(map[string]interface{}))
+		l.lists = append(l.lists, list)
+		return map[string]interface{}{"insert_list": map[string]interface{}{"id": l.nextID, "list": list}}
+	case "UpdateList":
+		return map[string]interface{}{"update_list": map[string]interface{}{"id": variables["id"]}}
+	case "DeleteList":
+		return map[string]interface{}{"delete_list": map[string]interface{}{"success": true}}
+	case "InsertListBook":
+		l.nextID++
+		listBook := map[string]interface{}{"id": l.nextID}
+		l.apply(listBook, variables["object"].(map[string]interface{}))
+		return map[string]interface{}{"insert_list_book": map[string]interface{}{"id": l.nextID, "list_book": listBook}}
+	case "DeleteListBook":
+		return map[string]interface{}{"delete_list_book": map[string]interface{}{"id": variables["id"], "list_id": 77}}
+	default:
+		return map[string]interface{}{"update_list_books": map[string]interface{}{"affected_rows": 1}}
+	}
+}
+
+// positionUpdates lists the list_books IDs and positions set by the
+// recorded UpdateListBookPosition mutations.
+func (l *fakeLibrary) positionUpdates() [][2]float64 {
+	var updates [][2]float64
+	for _, mutation := range l.mutations {
+		if operationPattern.FindStringSubmatch(mutation.Query)[1] == "UpdateListBookPosition" {
+			updates = append(updates, [2]float64{mutation.Variables["id"].(float64), mutation.Variables["position"].(float64)})
+		}
+	}
+	return updates
+}
+
+func TestListCreateCmd(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	cmd, output := newFlagTestCommand(t, url, "text", addListCreateFlags,
+		"--ranked", "--privacy", "followers", "--description", "Rockets")
+
+	require.NoError(t, listCreateCmd.RunE(cmd, []string{"Favourite", "SF"}))
+
+	assert.Equal(t, "Created list \"Favourite SF\" (list 501).\n"+
+		"   URL: https://hardcover.app/@alice/lists/favourite-sf-2\n", output.String())
+	require.Equal(t, []string{"InsertList"}, library.operations())
+	assert.Equal(t, map[string]interface{}{
+		"name": "Favourite SF", "description": "Rockets", "ranked": true, "privacy_setting_id": float64(2),
+	}, library.mutations[0].Variables["object"])
+}
+
+func TestListShowCmd_BySlug(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.lists = testLists()
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, listShowCmd.RunE(cmd, []string{"favourite-sf"}))
+
+	assert.Equal(t, "Favourite SF (ranked, public)\n"+
+		"   By: @alice\n   Description: The best of the genre\n   List ID: 77\n"+
+		"   URL: https://hardcover.app/@alice/lists/favourite-sf\n\n"+
+		"1. Dune by Frank Herbert\n   Reason: The one that started it all\n"+
+		"2. Hyperion (1962)\n3. Foundation (1963)\n", output.String())
+}
+
+func TestListShowCmd_NotFound(t *testing.T) {
+	_, url := newFakeLibrary(t, nil)
+	cmd, _ := newBookTestCommand(url, "text")
+
+	err := listShowCmd.RunE(cmd, []string{"no-such-list"})
+	require.Error(t, err)
+	assert.Equal(t, `list "no-such-list" not found`, err.Error())
+	assert.Equal(t, exitCodeNotFound, exitCode(err))
+}
+
+func TestListAddCmd(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.lists = testLists()
+	library.lists[0]["list_books"] = library.lists[0]["list_books"].([]interface{})[1:]
+	cmd, output := newFlagTestCommand(t, url, "text", addListAddFlags, "--reason", "Spice")
+
+	require.NoError(t, listAddCmd.RunE(cmd, []string{"77", "dune"}))
+
+	assert.Equal(t, "Added \"Dune\" to \"Favourite SF\" at position 3.\n", output.String())
+	require.Equal(t, []string{"InsertListBook"}, library.operations())
+	assert.Equal(t, map[string]interface{}{
+		"list_id": float64(77), "book_id": float64(328491), "position": float64(3), "reason": "Spice",
+	}, library.mutations[0].Variables["object"])
+}
+
+func TestListAddCmd_AlreadyOnList(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.lists = testLists()
+	cmd, output := newFlagTestCommand(t, url, "json", addListAddFlags)
+
+	require.NoError(t, listAddCmd.RunE(cmd, []string{"favourite-sf", "dune"}))
+
+	assert.Contains(t, output.String(), `"action": "unchanged"`)
+	assert.Contains(t, output.String(), `"position": 1`)
+	assert.Empty(t, library.mutations)
+	assert.Equal(t, 1, library.calls["GetCurrentUserID"])
+}
+
+func TestListRemoveCmd_ClosesGap(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.lists = testLists()
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, listRemoveCmd.RunE(cmd, []string{"favourite-sf", "dune"}))
+
+	assert.Equal(t, "Removed \"Dune\" from \"Favourite SF\".\n", output.String())
+	require.Equal(t, []string{"DeleteListBook", "UpdateListBookPosition", "UpdateListBookPosition"}, library.operations())
+	assert.Equal(t, float64(701), library.mutations[0].Variables["id"])
+	assert.Equal(t, [][2]float64{{702, 1}, {703, 2}}, library.positionUpdates())
+}
+
+func TestListMoveCmd(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.lists = testLists()
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, listMoveCmd.RunE(cmd, []string{"favourite-sf", "dune", "3"}))
+
+	assert.Equal(t, "Moved \"Dune\" to position 3 on \"Favourite SF\".\n", output.String())
+	assert.Equal(t, [][2]float64{{702, 1}, {703, 2}, {701, 3}}, library.positionUpdates())
+}
+
+func TestListMoveCmd_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		unrank  bool
+		wantErr string
+	}{
+		{name: "position", args: []string{"favourite-sf", "dune", "first"}, wantErr: `invalid position "first"`},
+		{name: "past the end", args: []string{"favourite-sf", "dune", "4"}, wantErr: `invalid position 4: "Favourite SF" has 3 books`},
+		{name: "unranked", args: []string{"favourite-sf", "dune", "2"}, unrank: true, wantErr: "is not a ranked list"},
+		{name: "other user", args: []string{"88", "dune", "1"}, wantErr: `list "88" belongs to another user`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			library, url := newFakeLibrary(t, nil)
+			library.lists = testLists()
+			library.lists[0]["ranked"] = !tt.unrank
+			cmd, _ := newBookTestCommand(url, "text")
+
+			err := listMoveCmd.RunE(cmd, tt.args)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Empty(t, library.mutations)
+		})
+	}
+}
+
+func TestListRenameAndPrivacyCmds(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.lists = testLists()
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, listRenameCmd.RunE(cmd, []string{"favourite-sf", "Favourite", "Science", "Fiction"}))
+	require.NoError(t, listPrivacyCmd.RunE(cmd, []string{"77", "private"}))
+	require.NoError(t, listPrivacyCmd.RunE(cmd, []string{"77", "public"}))
+
+	assert.Equal(t, "Renamed \"Favourite SF\" to \"Favourite Science Fiction\".\n"+
+		"   URL: https://hardcover.app/@alice/lists/favourite-sf\n"+
+		"\"Favourite SF\" is now private.\n   URL: https://hardcover.app/@alice/lists/favourite-sf\n"+
+		"\"Favourite SF\" is already public.\n   URL: https://hardcover.app/@alice/lists/favourite-sf\n", output.String())
+	require.Equal(t, []string{"UpdateList", "UpdateList"}, library.operations())
+	assert.Equal(t, map[string]interface{}{"name": "Favourite Science Fiction"}, library.mutations[0].Variables["object"])
+	assert.Equal(t, map[string]interface{}{"privacy_setting_id": float64(3)}, library.mutations[1].Variables["object"])
+
+	err := listPrivacyCmd.RunE(cmd, []string{"77", "friends"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown privacy setting "friends"`)
+}
+
+func TestListDeleteCmd(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	library.lists = testLists()
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, listDeleteCmd.RunE(cmd, []string{"favourite-sf"}))
+
+	assert.Equal(t, "Deleted list \"Favourite SF\" (3 books).\n", output.String())
+	require.Equal(t, []string{"DeleteList"}, library.operations())
+	assert.Equal(t, float64(77), library.mutations[0].Variables["id"])
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -48,42 +49,69 @@ Example:
 			return fmt.Errorf("failed to get user profile: %w", err)
 		}
 
-		// Display the user information
-		printToStdoutf(cmd.OutOrStdout(), "User Profile:\n")
-
 		if response.Me == nil {
 			return errors.New("no user data received")
 		}
 
-		user := response.Me
-		printToStdoutf(cmd.OutOrStdout(), "  ID: %d\n", user.ID)
-		if user.Username != "" {
-			printToStdoutf(cmd.OutOrStdout(), "  Username: %s\n", user.Username)
-		}
-		if user.Email != "" {
-			printToStdoutf(cmd.OutOrStdout(), "  Email: %s\n", user.Email)
-		}
-		if user.Name != "" {
-			printToStdoutf(cmd.OutOrStdout(), "  Name: %s\n", user.Name)
-		}
-		if user.Bio != "" {
-			printToStdoutf(cmd.OutOrStdout(), "  Bio: %s\n", user.Bio)
-		}
-		if user.Location != "" {
-			printToStdoutf(cmd.OutOrStdout(), "  Location: %s\n", user.Location)
-		}
-		if user.Created_at != nil {
-			printToStdoutf(
-				cmd.OutOrStdout(),
-				"  Created: %s\n",
-				time.Time(*user.Created_at).Format("2006-01-02 15:04:05"),
-			)
-		}
-
-		return nil
+		profile := newUserProfile(response.Me)
+		return render(cmd, profile, func(w io.Writer) {
+			printUserProfile(w, profile)
+		})
 	},
 }
 
+// userProfile is the structured form of the me command's output. Field names
+// follow the users type in the Hardcover schema.
+type userProfile struct {
+	ID        int    `json:"id"`
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+	Name      string `json:"name"`
+	Bio       string `json:"bio"`
+	Location  string `json:"location"`
+	CreatedAt string `json:"created_at"`
+}
+
+// newUserProfile converts a client user into its output representation.
+func newUserProfile(user *client.Users) *userProfile {
+	profile := &userProfile{
+		ID:       user.ID,
+		Username: user.Username,
+		Email:    user.Email,
+		Name:     user.Name,
+		Bio:      user.Bio,
+		Location: user.Location,
+	}
+	if user.Created_at != nil {
+		profile.CreatedAt = time.Time(*user.Created_at).Format("2006-01-02 15:04:05")
+	}
+	return profile
+}
+
+// printUserProfile writes the human-readable profile.
+func printUserProfile(w io.Writer, user *userProfile) {
+	printToStdoutf(w, "User Profile:\n")
+	printToStdoutf(w, "  ID: %d\n", user.ID)
+	if user.Username != "" {
+		printToStdoutf(w, "  Username: %s\n", user.Username)
+	}
+	if user.Email != "" {
+		printToStdoutf(w, "  Email: %s\n", user.Email)
+	}
+	if user.Name != "" {
+		printToStdoutf(w, "  Name: %s\n", user.Name)
+	}
+	if user.Bio != "" {
+		printToStdoutf(w, "  Bio: %s\n", user.Bio)
+	}
+	if user.Location != "" {
+		printToStdoutf(w, "  Location: %s\n", user.Location)
+	}
+	if user.CreatedAt != "" {
+		printToStdoutf(w, "  Created: %s\n", user.CreatedAt)
+	}
+}
+
 // setupMeCommands registers the me command with the root command.
 func setupMeCommands() {
 	rootCmd.AddCommand(meCmd)
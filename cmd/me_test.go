@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -230,3 +231,56 @@ func TestGetCurrentUserResponse_JSONUnmarshal(t *testing.T) {
 	assert.Equal(t, 123, response.Me.ID)
 	assert.Equal(t, "testuser", response.Me.Username)
 }
+
+func TestMeCmd_JSONOutput(t *testing.T) {
+	userData := map[string]interface{}{
+		"me": map[string]interface{}{
+			"id":       123,
+			"username": "testuser",
+			"location": "Leeds",
+		},
+	}
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(userData))
+	defer server.Close()
+
+	cfg := testutil.SetupTestConfig(&testutil.TestConfig{
+		APIKey:  "test-api-key",
+		BaseURL: server.URL,
+	})
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("output", "json", "")
+	cmd.SetContext(testutil.WithTestConfigAdapter(context.Background(), cfg))
+	var output bytes.Buffer
+	cmd.SetOut(&output)
+
+	err := meCmd.RunE(cmd, []string{})
+	require.NoError(t, err)
+
+	var profile map[string]interface{}
+	require.NoError(t, json.Unmarshal(output.Bytes(), &profile))
+	assert.InDelta(t, 123, profile["id"], 0)
+	assert.Equal(t, "testuser", profile["username"])
+	assert.Equal(t, "Leeds", profile["location"])
+	assert.NotContains(t, output.String(), "User Profile:")
+}
+
+func TestMeCmd_InvalidOutputFormat(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(map[string]interface{}{
+		"me": map[string]interface{}{"id": 1},
+	}))
+	defer server.Close()
+
+	cfg := testutil.SetupTestConfig(&testutil.TestConfig{
+		APIKey:  "test-api-key",
+		BaseURL: server.URL,
+	})
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("output", "xml", "")
+	cmd.SetContext(testutil.WithTestConfigAdapter(context.Background(), cfg))
+
+	err := meCmd.RunE(cmd, []string{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported output format")
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/output"
+)
+
+// outputFormat returns the format selected with the persistent --output flag,
+// defaulting to text when the flag is not registered (e.g. in unit tests).
+func outputFormat(cmd *cobra.Command) (output.Format, error) {
+	flag := cmd.Flag("output")
+	if flag == nil {
+		return output.FormatText, nil
+	}
+	return output.ParseFormat(flag.Value.String())
+}
+
+// render writes data to the command's output in the selected format. The
+// text function provides the human-readable presentation used by the default
+// text format.
+func render(cmd *cobra.Command, data interface{}, text func(w io.Writer)) error {
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	return output.Render(cmd.OutOrStdout(), format, withText(data, text))
+}
+
+// newStream opens a stream that writes batches of results to the command's
+// output in the selected format as they arrive.
+func newStream(cmd *cobra.Command) (*output.Stream, error) {
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return output.NewStream(cmd.OutOrStdout(), format)
+}
+
+// pluralize formats a count with the singular or plural form of its noun,
+// e.g. "1 book" or "3 books".
+func pluralize(count int, singular, plural string) string {
+	if count == 1 {
+		return "1 " + singular
+	}
+	return strconv.Itoa(count) + " " + plural
+}
+
+// withText pairs data with a text function for use with a stream.
+func withText(data interface{}, text func(w io.Writer)) interface{} {
+	return output.WithText(data, func(w io.Writer) error {
+		text(w)
+		return nil
+	})
+}
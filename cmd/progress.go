@@ -0,0 +1,610 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// Outcomes of a progress command, besides libraryActionUnchanged.
+const (
+	progressActionLogged   = "progress"
+	progressActionStarted  = "started"
+	progressActionResumed  = "resumed"
+	progressActionPaused   = "paused"
+	progressActionFinished = "finished"
+)
+
+// percentScale converts between fractions and percentages.
+const percentScale = 100
+
+// progressCmd represents the progress command.
+var progressCmd = &cobra.Command{
+	Use:   "progress <book> [page]",
+	Short: "Track your reading progress",
+	Long: `Record how far you are into a book, identified by Hardcover ID, URL slug
+or ISBN. Give exactly one of:
+
+  a page number      the page you are on
+  --percent          how far through the book you are, e.g. 40
+  --time             how far into an audiobook you are, e.g. 3h12m
+
+Progress is recorded on your current read of the book. If you have no read
+in progress, a new one starts today, so re-reading a finished book keeps
+the earlier read as its own record. The book is shelved as Currently
+Reading, and a paused read is resumed.
+
+Percentages are converted to pages using the page count of your edition
+or, for audiobooks without one, to a position using the audio length.
+
+Available subcommands:
+  start      Start or resume reading a book
+  pause      Pause your current read of a book
+  finish     Finish your current read of a book
+
+Example:
+  hardcover progress dune 142
+  hardcover progress dune --percent 40
+  hardcover progress project-hail-mary --time 3h12m
+  hardcover progress start dune
+  hardcover progress finish dune --date 2024-06-30`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		target, err := parseProgressTarget(cmd, args[1:])
+		if err != nil {
+			return err
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		book, err := resolveLibraryBook(ctx, gqlClient, args[0])
+		if err != nil {
+			return err
+		}
+		entry, err := readingEntry(ctx, gqlClient, book, client.StatusCurrentlyReading)
+		if err != nil {
+			return err
+		}
+
+		read := entry.ActiveRead()
+		input := newReadInput(entry, read, time.Now().Format(time.DateOnly))
+		pages, seconds := readLength(book, entry, read)
+		if err := target.apply(input, book.Title, pages, seconds); err != nil {
+			return err
+		}
+		input.PausedAt = nil
+
+		view, err := saveRead(ctx, gqlClient, book, entry, read, input, progressActionLogged)
+		if err != nil {
+			return err
+		}
+		return renderProgress(cmd, view)
+	},
+}
+
+// progressStartCmd represents the progress start command.
+var progressStartCmd = &cobra.Command{
+	Use:   "start <book>",
+	Short: "Start or resume reading a book",
+	Long: `Start reading a book, or resume it if your current read is paused. The
+book is shelved as Currently Reading. A book you have finished before gets
+a new read, keeping the earlier one as its own record.
+
+Example:
+  hardcover progress start dune
+  hardcover progress start dune --date 2024-06-01`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runReadChange(cmd, args[0], client.StatusCurrentlyReading, startRead)
+	},
+}
+
+// progressPauseCmd represents the progress pause command.
+var progressPauseCmd = &cobra.Command{
+	Use:   "pause <book>",
+	Short: "Pause your current read of a book",
+	Long: `Pause your current read of a book and shelve it as Paused. Resume it with
+"hardcover progress start" or by recording progress.
+
+Example:
+  hardcover progress pause dune`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runReadChange(cmd, args[0], client.StatusPaused, pauseRead)
+	},
+}
+
+// progressFinishCmd represents the progress finish command.
+var progressFinishCmd = &cobra.Command{
+	Use:   "finish <book>",
+	Short: "Finish your current read of a book",
+	Long: `Finish your current read of a book, recording it as read to the last page,
+and shelve it as Read. Without a read in progress, a finished read is
+recorded for the date alone.
+
+Example:
+  hardcover progress finish dune
+  hardcover progress finish dune --date 2024-06-30`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runReadChange(cmd, args[0], client.StatusRead, finishRead)
+	},
+}
+
+// progressTarget is the position given to the progress command: a page, a
+// percentage or a listening time.
+type progressTarget struct {
+	page    int
+	percent float64
+	seconds int
+}
+
+// parseProgressTarget reads the position from the optional page argument
+// and the --percent and --time flags, of which exactly one must be given.
+func parseProgressTarget(cmd *cobra.Command, args []string) (*progressTarget, error) {
+	target := &progressTarget{}
+	given := len(args)
+	if given > 0 {
+		page, err := strconv.Atoi(args[0])
+		if err != nil || page <= 0 {
+			return nil, fmt.Errorf("invalid page %q: use a positive whole number", args[0])
+		}
+		target.page = page
+	}
+
+	if flag := cmd.Flags().Lookup("percent"); flag != nil && flag.Changed {
+		given++
+		percent, err := cmd.Flags().GetFloat64("percent")
+		if err != nil {
+			return nil, err
+		}
+		if percent <= 0 || percent > percentScale {
+			return nil, fmt.Errorf("invalid percentage %s: use a number above 0 and up to 100", formatRating(percent))
+		}
+		target.percent = percent
+	}
+
+	var listened string
+	if err := readStringFlags(cmd, map[string]*string{"time": &listened}); err != nil {
+		return nil, err
+	}
+	if listened != "" {
+		given++
+		duration, err := time.ParseDuration(listened)
+		if err != nil || duration < time.Second {
+			return nil, fmt.Errorf("invalid time %q: use a duration such as 3h12m or 45m", listened)
+		}
+		target.seconds = int(duration.Seconds())
+	}
+
+	switch given {
+	case 0:
+		return nil, errors.New("give a page number, --percent or --time")
+	case 1:
+		return target, nil
+	default:
+		return nil, errors.New("give only one of a page number, --percent or --time")
+	}
+}
+
+// apply records the target position on input, given the length of the book
+// in pages and audio seconds, either of which may be zero when unknown.
+func (t *progressTarget) apply(input *client.UserBookReadInput, title string, pages, seconds int) error {
+	switch {
+	case t.page > 0:
+		if pages > 0 && t.page > pages {
+			return fmt.Errorf("page %d is past the end of %q (%d pages)", t.page, title, pages)
+		}
+		input.ProgressPages, input.ProgressSeconds = &t.page, nil
+	case t.seconds > 0:
+		if seconds > 0 && t.seconds > seconds {
+			return fmt.Errorf("%s is past the end of %q (%s)", formatListeningTime(t.seconds), title, formatListeningTime(seconds))
+		}
+		input.ProgressPages, input.ProgressSeconds = nil, &t.seconds
+	case pages > 0:
+		page := int(math.Round(t.percent * float64(pages) / percentScale))
+		input.ProgressPages, input.ProgressSeconds = &page, nil
+	case seconds > 0:
+		position := int(math.Round(t.percent * float64(seconds) / percentScale))
+		input.ProgressPages, input.ProgressSeconds = nil, &position
+	default:
+		return fmt.Errorf("%q has no page count or audio length; give a page number or --time instead", title)
+	}
+	return nil
+}
+
+// readChange turns the state of a read into the state to save, given the
+// date of the change and the book's length. read is nil when no read is in
+// progress. It returns the action taken, or libraryActionUnchanged.
+type readChange func(
+	entry *client.UserBook, read *client.UserBookRead, date string, pages, seconds int,
+) (*client.UserBookReadInput, string, error)
+
+// runReadChange runs the start, pause and finish subcommands: it shelves
+// the book with status and applies change to its current read.
+func runReadChange(cmd *cobra.Command, identifier string, status client.UserBookStatus, change readChange) error {
+	date, err := readDateFlag(cmd)
+	if err != nil {
+		return err
+	}
+
+	gqlClient, err := newAuthenticatedClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	book, err := resolveLibraryBook(ctx, gqlClient, identifier)
+	if err != nil {
+		return err
+	}
+	entry, err := gqlClient.GetMyUserBook(ctx, book.ID)
+	switch {
+	case errors.Is(err, client.ErrNotFound) && status == client.StatusPaused:
+		return fmt.Errorf("%q is not in your library", book.Title)
+	case errors.Is(err, client.ErrNotFound):
+		entry = nil
+	case err != nil:
+		return fmt.Errorf("failed to get library entry: %w", err)
+	}
+
+	read := (*client.UserBookRead)(nil)
+	if entry != nil {
+		read = entry.ActiveRead()
+	}
+	if status == client.StatusPaused && read == nil {
+		return fmt.Errorf("no read of %q is in progress; start one with \"hardcover progress start\"", book.Title)
+	}
+
+	if entry, err = shelveForReading(ctx, gqlClient, book, entry, status); err != nil {
+		return err
+	}
+	pages, seconds := readLength(book, entry, read)
+	input, action, err := change(entry, read, date, pages, seconds)
+	if err != nil {
+		return err
+	}
+
+	view := newProgressView(book, entry, read, input, action)
+	if action != libraryActionUnchanged {
+		if view, err = saveRead(ctx, gqlClient, book, entry, read, input, action); err != nil {
+			return err
+		}
+	}
+	return renderProgress(cmd, view)
+}
+
+// startRead starts a new read, or resumes a paused one.
+func startRead(
+	entry *client.UserBook, read *client.UserBookRead, date string, _, _ int,
+) (*client.UserBookReadInput, string, error) {
+	switch {
+	case read == nil:
+		return newReadInput(entry, nil, date), progressActionStarted, nil
+	case read.PausedAt != "":
+		input := read.Input()
+		input.PausedAt = nil
+		return input, progressActionResumed, nil
+	default:
+		return read.Input(), libraryActionUnchanged, nil
+	}
+}
+
+// pauseRead pauses the current read.
+func pauseRead(
+	_ *client.UserBook, read *client.UserBookRead, date string, _, _ int,
+) (*client.UserBookReadInput, string, error) {
+	input := read.Input()
+	if read.PausedAt != "" {
+		return input, libraryActionUnchanged, nil
+	}
+	input.PausedAt = &date
+	return input, progressActionPaused, nil
+}
+
+// finishRead finishes the current read, or records a finished read when
+// none is in progress, at the last page or second of the book.
+func finishRead(
+	entry *client.UserBook, read *client.UserBookRead, date string, pages, seconds int,
+) (*client.UserBookReadInput, string, error) {
+	input := &client.UserBookReadInput{EditionID: entry.EditionID}
+	if read != nil {
+		input = read.Input()
+	}
+	input.PausedAt = nil
+	input.FinishedAt = &date
+	switch {
+	case pages > 0:
+		input.ProgressPages, input.ProgressSeconds = &pages, nil
+	case seconds > 0:
+		input.ProgressPages, input.ProgressSeconds = nil, &seconds
+	}
+	return input, progressActionFinished, nil
+}
+
+// readDateFlag returns the --date flag, defaulting to today.
+func readDateFlag(cmd *cobra.Command) (string, error) {
+	var date string
+	if err := readStringFlags(cmd, map[string]*string{"date": &date}); err != nil {
+		return "", err
+	}
+	if date == "" {
+		return time.Now().Format(time.DateOnly), nil
+	}
+	if _, err := time.Parse(time.DateOnly, date); err != nil {
+		return "", fmt.Errorf("invalid date %q: use YYYY-MM-DD", date)
+	}
+	return date, nil
+}
+
+// readingEntry returns the user's entry for book with the given status,
+// adding the book or changing its status as needed.
+func readingEntry(
+	ctx context.Context, c *client.Client, book *client.BookDetail, status client.UserBookStatus,
+) (*client.UserBook, error) {
+	entry, err := c.GetMyUserBook(ctx, book.ID)
+	if errors.Is(err, client.ErrNotFound) {
+		entry = nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get library entry: %w", err)
+	}
+	return shelveForReading(ctx, c, book, entry, status)
+}
+
+// shelveForReading gives the user's entry for book the given status,
+// adding the book when entry is nil.
+func shelveForReading(
+	ctx context.Context, c *client.Client, book *client.BookDetail, entry *client.UserBook, status client.UserBookStatus,
+) (*client.UserBook, error) {
+	if entry == nil {
+		added, err := c.AddUserBook(ctx, &client.UserBookInput{BookID: book.ID, StatusID: status})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add book: %w", err)
+		}
+		added.StatusID = status
+		return added, nil
+	}
+	if entry.StatusID != status {
+		if _, err := c.UpdateUserBook(ctx, entry.ID, &client.UserBookInput{StatusID: status}); err != nil {
+			return nil, fmt.Errorf("failed to update status: %w", err)
+		}
+		entry.StatusID = status
+	}
+	return entry, nil
+}
+
+// newReadInput returns the state of read to modify, or of a new read
+// starting on date when read is nil.
+func newReadInput(entry *client.UserBook, read *client.UserBookRead, date string) *client.UserBookReadInput {
+	if read != nil {
+		return read.Input()
+	}
+	return &client.UserBookReadInput{EditionID: entry.EditionID, StartedAt: &date}
+}
+
+// readLength returns the pages and audio seconds of book as read, falling
+// back to the book's page count when neither is known.
+func readLength(book *client.BookDetail, entry *client.UserBook, read *client.UserBookRead) (int, int) {
+	pages, seconds := entry.Length(read)
+	if pages == 0 && seconds == 0 {
+		pages = book.Pages
+	}
+	return pages, seconds
+}
+
+// saveRead stores input as a change to read, or as a new read of entry when
+// read is nil.
+func saveRead(
+	ctx context.Context, c *client.Client, book *client.BookDetail, entry *client.UserBook,
+	read *client.UserBookRead, input *client.UserBookReadInput, action string,
+) (*progressView, error) {
+	var saved *client.UserBookRead
+	var err error
+	if read == nil {
+		saved, err = c.AddUserBookRead(ctx, entry.ID, input)
+	} else {
+		saved, err = c.UpdateUserBookRead(ctx, read.ID, input)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save reading progress: %w", err)
+	}
+
+	view := newProgressView(book, entry, read, input, action)
+	view.ReadID = saved.ID
+	return view, nil
+}
+
+// progressView is the structured form of a progress command's outcome.
+type progressView struct {
+	Action          string  `json:"action"`
+	UserBookID      int     `json:"user_book_id"`
+	BookID          int     `json:"book_id"`
+	Title           string  `json:"title"`
+	Status          string  `json:"status"`
+	ReadID          int     `json:"read_id"`
+	ReadNumber      int     `json:"read_number"`
+	NewRead         bool    `json:"new_read"`
+	StartedAt       string  `json:"started_at"`
+	PausedAt        string  `json:"paused_at"`
+	FinishedAt      string  `json:"finished_at"`
+	ProgressPages   int     `json:"progress_pages"`
+	TotalPages      int     `json:"total_pages"`
+	ProgressSeconds int     `json:"progress_seconds"`
+	TotalSeconds    int     `json:"total_seconds"`
+	Percent         float64 `json:"percent"`
+}
+
+// newProgressView describes the state input gives a read of book. read is
+// the read before the change, or nil for a new read.
+func newProgressView(
+	book *client.BookDetail, entry *client.UserBook, read *client.UserBookRead,
+	input *client.UserBookReadInput, action string,
+) *progressView {
+	view := &progressView{
+		Action:     action,
+		UserBookID: entry.ID,
+		BookID:     book.ID,
+		Title:      book.Title,
+		Status:     entry.StatusID.String(),
+		ReadNumber: len(entry.Reads) + 1,
+		NewRead:    read == nil,
+	}
+	if read != nil {
+		view.ReadID = read.ID
+		for i := range entry.Reads {
+			if entry.Reads[i].ID == read.ID {
+				view.ReadNumber = i + 1
+			}
+		}
+	}
+	for _, field := range []struct {
+		value *string
+		dest  *string
+	}{
+		{input.StartedAt, &view.StartedAt},
+		{input.PausedAt, &view.PausedAt},
+		{input.FinishedAt, &view.FinishedAt},
+	} {
+		if field.value != nil {
+			*field.dest = *field.value
+		}
+	}
+
+	view.TotalPages, view.TotalSeconds = readLength(book, entry, read)
+	if input.ProgressPages != nil {
+		view.ProgressPages = *input.ProgressPages
+	}
+	if input.ProgressSeconds != nil {
+		view.ProgressSeconds = *input.ProgressSeconds
+	}
+	view.Percent = progressPercent(view.ProgressPages, view.TotalPages, view.ProgressSeconds, view.TotalSeconds)
+	return view
+}
+
+// progressPercent returns how far through a book a position is, rounded to
+// a whole percentage, or zero when the length is unknown.
+func progressPercent(pages, totalPages, seconds, totalSeconds int) float64 {
+	switch {
+	case pages > 0 && totalPages > 0:
+		return math.Round(float64(pages) * percentScale / float64(totalPages))
+	case seconds > 0 && totalSeconds > 0:
+		return math.Round(float64(seconds) * percentScale / float64(totalSeconds))
+	default:
+		return 0
+	}
+}
+
+// describePosition formats a read's position, e.g. "page 142 of 412 (34%)"
+// or "3h12m of 15h40m (20%)". It returns "" when there is no position.
+func describePosition(pages, totalPages, seconds, totalSeconds int, percent float64) string {
+	var position string
+	switch {
+	case pages > 0:
+		position = "page " + strconv.Itoa(pages)
+		if totalPages > 0 {
+			position += " of " + strconv.Itoa(totalPages)
+		}
+	case seconds > 0:
+		position = formatListeningTime(seconds)
+		if totalSeconds > 0 {
+			position += " of " + formatListeningTime(totalSeconds)
+		}
+	default:
+		return ""
+	}
+	if percent > 0 {
+		position += fmt.Sprintf(" (%.0f%%)", percent)
+	}
+	return position
+}
+
+// formatListeningTime formats seconds as hours and minutes, e.g. "3h12m".
+func formatListeningTime(seconds int) string {
+	duration := time.Duration(seconds) * time.Second
+	hours, minutes := int(duration/time.Hour), int(duration%time.Hour/time.Minute)
+	if hours == 0 {
+		return strconv.Itoa(minutes) + "m"
+	}
+	return fmt.Sprintf("%dh%02dm", hours, minutes)
+}
+
+// renderProgress writes the outcome of a progress command.
+func renderProgress(cmd *cobra.Command, view *progressView) error {
+	return render(cmd, view, func(w io.Writer) {
+		printProgress(w, view)
+	})
+}
+
+// printProgress writes a one-line summary of a progress change.
+func printProgress(w io.Writer, view *progressView) {
+	position := describePosition(view.ProgressPages, view.TotalPages, view.ProgressSeconds, view.TotalSeconds, view.Percent)
+	switch view.Action {
+	case progressActionLogged:
+		if view.NewRead {
+			printStartedRead(w, view)
+		}
+		printToStdoutf(w, "%q: %s.\n", view.Title, position)
+	case progressActionStarted:
+		printStartedRead(w, view)
+	case progressActionResumed:
+		printToStdoutf(w, "Resumed reading %q.\n", view.Title)
+	case progressActionPaused:
+		printToStdoutf(w, "Paused %q on %s.\n", view.Title, view.PausedAt)
+	case progressActionFinished:
+		printToStdoutf(w, "Finished %q on %s.\n", view.Title, view.FinishedAt)
+		return
+	case libraryActionUnchanged:
+		if view.PausedAt != "" {
+			printToStdoutf(w, "%q is already paused.\n", view.Title)
+		} else {
+			printToStdoutf(w, "Already reading %q since %s.\n", view.Title, view.StartedAt)
+		}
+	}
+	if position != "" && view.Action != progressActionLogged {
+		printToStdoutf(w, "  At %s\n", position)
+	}
+}
+
+// printStartedRead announces a new read, numbering re-reads.
+func printStartedRead(w io.Writer, view *progressView) {
+	if view.ReadNumber > 1 {
+		printToStdoutf(w, "Started re-reading %q on %s (read #%d).\n", view.Title, view.StartedAt, view.ReadNumber)
+		return
+	}
+	printToStdoutf(w, "Started reading %q on %s.\n", view.Title, view.StartedAt)
+}
+
+// addProgressFlags registers the flags of the progress command.
+func addProgressFlags(cmd *cobra.Command) {
+	cmd.Flags().Float64("percent", 0, "how far through the book you are, as a percentage")
+	cmd.Flags().String("time", "", "how far into the audiobook you are, e.g. 3h12m")
+}
+
+// addReadDateFlag registers the --date flag of the start, pause and finish
+// subcommands.
+func addReadDateFlag(cmd *cobra.Command) {
+	cmd.Flags().String("date", "", "date of the change as YYYY-MM-DD (default today)")
+}
+
+// setupProgressCommands registers the progress commands with the root
+// command.
+func setupProgressCommands() {
+	addProgressFlags(progressCmd)
+	for _, sub := range []*cobra.Command{progressStartCmd, progressPauseCmd, progressFinishCmd} {
+		addReadDateFlag(sub)
+		progressCmd.AddCommand(sub)
+	}
+	rootCmd.AddCommand(progressCmd)
+}
@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/client"
+)
+
+// readingDune is a shelf entry for Dune, 617 pages, with the given status
+// and reads.
+func readingDune(status int, reads ...interface{}) map[int]map[string]interface{} {
+	return map[int]map[string]interface{}{
+		328491: {"id": 7, "book_id": 328491, "status_id": status, "user_book_reads": reads},
+	}
+}
+
+// operations lists the operation names of the recorded mutations.
+func (l *fakeLibrary) operations() []string {
+	names := make([]string, len(l.mutations))
+	for i, mutation := range l.mutations {
+		names[i] = operationPattern.FindStringSubmatch(mutation.Query)[1]
+	}
+	return names
+}
+
+func TestProgressCmd_UpdatesActiveRead(t *testing.T) {
+	library, url := newFakeLibrary(t, readingDune(2,
+		map[string]interface{}{"id": 70, "started_at": "2024-01-01", "finished_at": "2024-01-20"},
+		map[string]interface{}{"id": 71, "started_at": "2024-03-01", "progress_pages": 10},
+	))
+	cmd, output := newFlagTestCommand(t, url, "text", addProgressFlags)
+
+	require.NoError(t, progressCmd.RunE(cmd, []string{"dune", "142"}))
+
+	assert.Equal(t, "\"Dune\": page 142 of 617 (23%).\n", output.String())
+	require.Equal(t, []string{"UpdateUserBookRead"}, library.operations())
+	assert.Equal(t, float64(71), library.mutations[0].Variables["id"])
+	assert.Equal(t, map[string]interface{}{
+		"edition_id": nil, "started_at": "2024-03-01", "paused_at": nil, "finished_at": nil,
+		"progress_pages": float64(142), "progress_seconds": nil,
+	}, library.mutations[0].Variables["object"])
+}
+
+func TestProgressCmd_RereadStartsNewRead(t *testing.T) {
+	library, url := newFakeLibrary(t, readingDune(3,
+		map[string]interface{}{"id": 70, "started_at": "2024-01-01", "finished_at": "2024-01-20", "progress_pages": 617},
+	))
+	cmd, output := newFlagTestCommand(t, url, "text", addProgressFlags, "--percent", "40")
+
+	require.NoError(t, progressCmd.RunE(cmd, []string{"dune"}))
+
+	today := time.Now().Format(time.DateOnly)
+	assert.Equal(t, "Started re-reading \"Dune\" on "+today+" (read #2).\n\"Dune\": page 247 of 617 (40%).\n", output.String())
+	require.Equal(t, []string{"UpdateUserBook", "InsertUserBookRead"}, library.operations())
+	assert.Equal(t, map[string]interface{}{"status_id": float64(2)}, library.mutations[0].Variables["object"])
+	assert.Equal(t, float64(7), library.mutations[1].Variables["user_book_id"])
+	read := library.mutations[1].Variables["user_book_read"].(map[string]interface{})
+	assert.Equal(t, today, read["started_at"])
+	assert.Equal(t, float64(247), read["progress_pages"])
+}
+
+func TestProgressCmd_AudiobookTime(t *testing.T) {
+	entries := readingDune(2)
+	entries[328491]["edition_id"] = 40
+	entries[328491]["edition"] = map[string]interface{}{"id": 40, "title": "Dune", "edition_format": "Audiobook", "audio_seconds": 75600}
+	library, url := newFakeLibrary(t, entries)
+	cmd, output := newFlagTestCommand(t, url, "json", addProgressFlags, "--time", "3h12m")
+
+	require.NoError(t, progressCmd.RunE(cmd, []string{"dune"}))
+
+	assert.Contains(t, output.String(), `"progress_seconds": 11520`)
+	assert.Contains(t, output.String(), `"total_seconds": 75600`)
+	assert.Contains(t, output.String(), `"percent": 15`)
+	require.Equal(t, []string{"InsertUserBookRead"}, library.operations())
+	read := library.mutations[0].Variables["user_book_read"].(map[string]interface{})
+	assert.Equal(t, float64(40), read["edition_id"])
+	assert.Equal(t, float64(11520), read["progress_seconds"])
+}
+
+func TestProgressCmd_InvalidTargets(t *testing.T) {
+	tests := []struct {
+		args    []string
+		flags   []string
+		wantErr string
+	}{
+		{args: []string{"dune"}, wantErr: "give a page number, --percent or --time"},
+		{args: []string{"dune", "10"}, flags: []string{"--percent", "5"}, wantErr: "give only one of"},
+		{args: []string{"dune", "ten"}, wantErr: `invalid page "ten"`},
+		{args: []string{"dune"}, flags: []string{"--percent", "120"}, wantErr: "invalid percentage 120"},
+		{args: []string{"dune"}, flags: []string{"--time", "soon"}, wantErr: `invalid time "soon"`},
+		{args: []string{"dune", "700"}, wantErr: `page 700 is past the end of "Dune" (617 pages)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(append(tt.args, tt.flags...), " "), func(t *testing.T) {
+			library, url := newFakeLibrary(t, readingDune(2))
+			cmd, _ := newFlagTestCommand(t, url, "text", addProgressFlags, tt.flags...)
+
+			err := progressCmd.RunE(cmd, tt.args)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Empty(t, library.mutations)
+		})
+	}
+}
+
+func TestProgressPauseCmd_PausesRead(t *testing.T) {
+	library, url := newFakeLibrary(t, readingDune(2,
+		map[string]interface{}{"id": 71, "started_at": "2024-03-01", "progress_pages": 142},
+	))
+	cmd, output := newFlagTestCommand(t, url, "text", addReadDateFlag, "--date", "2024-03-10")
+
+	require.NoError(t, progressPauseCmd.RunE(cmd, []string{"dune"}))
+
+	assert.Equal(t, "Paused \"Dune\" on 2024-03-10.\n  At page 142 of 617 (23%)\n", output.String())
+	require.Equal(t, []string{"UpdateUserBook", "UpdateUserBookRead"}, library.operations())
+	assert.Equal(t, map[string]interface{}{"status_id": float64(client.StatusPaused)}, library.mutations[0].Variables["object"])
+	assert.Equal(t, "2024-03-10", library.mutations[1].Variables["object"].(map[string]interface{})["paused_at"])
+}
+
+func TestProgressPauseCmd_RequiresActiveRead(t *testing.T) {
+	library, url := newFakeLibrary(t, readingDune(1))
+	cmd, _ := newBookTestCommand(url, "text")
+
+	err := progressPauseCmd.RunE(cmd, []string{"dune"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `no read of "Dune" is in progress`)
+	assert.Empty(t, library.mutations)
+}
+
+func TestProgressStartCmd_ResumesPausedRead(t *testing.T) {
+	library, url := newFakeLibrary(t, readingDune(4,
+		map[string]interface{}{"id": 71, "started_at": "2024-03-01", "paused_at": "2024-03-10", "progress_pages": 142},
+	))
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, progressStartCmd.RunE(cmd, []string{"dune"}))
+
+	assert.Equal(t, "Resumed reading \"Dune\".\n  At page 142 of 617 (23%)\n", output.String())
+	require.Equal(t, []string{"UpdateUserBook", "UpdateUserBookRead"}, library.operations())
+	read := library.mutations[1].Variables["object"].(map[string]interface{})
+	assert.Nil(t, read["paused_at"])
+	assert.Equal(t, "2024-03-01", read["started_at"])
+}
+
+func TestProgressStartCmd_AlreadyReading(t *testing.T) {
+	library, url := newFakeLibrary(t, readingDune(2,
+		map[string]interface{}{"id": 71, "started_at": "2024-03-01"},
+	))
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, progressStartCmd.RunE(cmd, []string{"dune"}))
+
+	assert.Equal(t, "Already reading \"Dune\" since 2024-03-01.\n", output.String())
+	assert.Empty(t, library.mutations)
+}
+
+func TestProgressFinishCmd_FinishesRead(t *testing.T) {
+	library, url := newFakeLibrary(t, readingDune(2,
+		map[string]interface{}{"id": 71, "started_at": "2024-03-01", "progress_pages": 500},
+	))
+	cmd, output := newFlagTestCommand(t, url, "text", addReadDateFlag, "--date", "2024-04-02")
+
+	require.NoError(t, progressFinishCmd.RunE(cmd, []string{"dune"}))
+
+	assert.Equal(t, "Finished \"Dune\" on 2024-04-02.\n", output.String())
+	require.Equal(t, []string{"UpdateUserBook", "UpdateUserBookRead"}, library.operations())
+	assert.Equal(t, map[string]interface{}{"status_id": float64(client.StatusRead)}, library.mutations[0].Variables["object"])
+	read := library.mutations[1].Variables["object"].(map[string]interface{})
+	assert.Equal(t, "2024-04-02", read["finished_at"])
+	assert.Equal(t, float64(617), read["progress_pages"])
+}
@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// progressBarWidth is the number of cells in a progress bar.
+const progressBarWidth = 20
+
+// hoursPerDay converts durations to days.
+const hoursPerDay = 24
+
+// readingCmd represents the reading command.
+var readingCmd = &cobra.Command{
+	Use:   "reading",
+	Short: "Show the books you are currently reading",
+	Long: `Show every book shelved as Currently Reading with your progress on the
+current read, how long ago you started and, from your pace so far, when you
+are projected to finish.
+
+Progress is measured against the page count of your edition, or the audio
+length for audiobooks. Record progress with "hardcover progress".
+
+Example:
+  hardcover reading
+  hardcover reading -o json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		userID, err := gqlClient.CurrentUserID(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get user profile: %w", err)
+		}
+		userBooks, err := gqlClient.GetUserBooks(ctx, &client.UserBookFilter{
+			UserID:   userID,
+			Statuses: []client.UserBookStatus{client.StatusCurrentlyReading},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get currently reading books: %w", err)
+		}
+
+		today := time.Now()
+		views := make([]readingBookView, len(userBooks))
+		for i := range userBooks {
+			views[i] = newReadingBookView(&userBooks[i], today)
+		}
+		return render(cmd, views, func(w io.Writer) {
+			printReadingBookViews(w, views)
+		})
+	},
+}
+
+// readingBookView is a book in the reading command's output. Pace is in
+// pages or audio seconds per day, matching the measured progress.
+type readingBookView struct {
+	UserBookID      int     `json:"user_book_id"`
+	BookID          int     `json:"book_id"`
+	Title           string  `json:"title"`
+	Authors         string  `json:"authors"`
+	StartedAt       string  `json:"started_at"`
+	DaysReading     int     `json:"days_reading"`
+	ProgressPages   int     `json:"progress_pages"`
+	TotalPages      int     `json:"total_pages"`
+	ProgressSeconds int     `json:"progress_seconds"`
+	TotalSeconds    int     `json:"total_seconds"`
+	Percent         float64 `json:"percent"`
+	PacePerDay      float64 `json:"pace_per_day"`
+	ProjectedFinish string  `json:"projected_finish"`
+	URL             string  `json:"url"`
+}
+
+// newReadingBookView describes the progress of a shelf entry's current read
+// as of today.
+func newReadingBookView(userBook *client.UserBook, today time.Time) readingBookView {
+	view := readingBookView{
+		UserBookID: userBook.ID,
+		BookID:     userBook.BookID,
+	}
+	if book := userBook.Book; book != nil {
+		view.Title = book.Title
+		view.Authors = strings.Join(authorNames(book.Contributions), ", ")
+		if book.Slug != "" {
+			view.URL = "https://hardcover.app/books/" + book.Slug
+		}
+	}
+
+	read := userBook.ActiveRead()
+	view.TotalPages, view.TotalSeconds = userBook.Length(read)
+	if read == nil {
+		return view
+	}
+	view.StartedAt = read.StartedAt
+	view.ProgressPages, view.ProgressSeconds = read.ProgressPages, read.ProgressSeconds
+	view.Percent = progressPercent(view.ProgressPages, view.TotalPages, view.ProgressSeconds, view.TotalSeconds)
+
+	started, err := time.ParseInLocation(time.DateOnly, read.StartedAt, today.Location())
+	if err != nil {
+		return view
+	}
+	day := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+	view.DaysReading = int(math.Round(day.Sub(started).Hours() / hoursPerDay))
+	projectFinish(&view, day)
+	return view
+}
+
+// projectFinish sets the pace of a read and, when it can be measured, the
+// date it will be finished if that pace holds. A read started today counts
+// as one day of reading.
+func projectFinish(view *readingBookView, today time.Time) {
+	done, total := view.ProgressPages, view.TotalPages
+	if done == 0 || total == 0 {
+		done, total = view.ProgressSeconds, view.TotalSeconds
+	}
+	if done == 0 || total == 0 || view.DaysReading < 0 {
+		return
+	}
+
+	view.PacePerDay = float64(done) / float64(max(view.DaysReading, 1))
+	remaining := float64(max(total-done, 0))
+	days := int(math.Ceil(remaining / view.PacePerDay))
+	view.ProjectedFinish = today.AddDate(0, 0, days).Format(time.DateOnly)
+}
+
+// progressBar draws a bar of progressBarWidth cells filled to percent.
+func progressBar(percent float64) string {
+	filled := min(int(math.Round(percent*progressBarWidth/percentScale)), progressBarWidth)
+	return "[" + strings.Repeat("█", filled) + strings.Repeat("░", progressBarWidth-filled) + "]"
+}
+
+// describePace formats a reading pace, e.g. "17.5 pages/day" or
+// "1h05m/day".
+func describePace(view *readingBookView) string {
+	if view.ProgressPages > 0 && view.TotalPages > 0 {
+		return fmt.Sprintf("%.1f pages/day", view.PacePerDay)
+	}
+	return formatListeningTime(int(view.PacePerDay)) + "/day"
+}
+
+// describeDaysAgo formats a number of days in the past.
+func describeDaysAgo(days int) string {
+	switch days {
+	case 0:
+		return "today"
+	case 1:
+		return "yesterday"
+	default:
+		return fmt.Sprintf("%d days ago", days)
+	}
+}
+
+// printReadingBookViews writes the reading dashboard as human-readable
+// text.
+func printReadingBookViews(w io.Writer, books []readingBookView) {
+	if len(books) == 0 {
+		printToStdoutf(w, "You are not reading any books right now.\n")
+		return
+	}
+
+	printToStdoutf(w, "Currently reading (%s)\n\n", pluralize(len(books), "book", "books"))
+	for i := range books {
+		book := &books[i]
+		title := book.Title
+		if book.Authors != "" {
+			title += " by " + book.Authors
+		}
+		printToStdoutf(w, "%d. %s\n", i+1, title)
+
+		position := describePosition(book.ProgressPages, book.TotalPages, book.ProgressSeconds, book.TotalSeconds, 0)
+		switch {
+		case position == "":
+			printToStdoutf(w, "   No progress recorded yet\n")
+		case book.Percent > 0:
+			printToStdoutf(w, "   %s %.0f%% · %s\n", progressBar(book.Percent), book.Percent, position)
+		default:
+			printToStdoutf(w, "   At %s\n", position)
+		}
+		if book.StartedAt != "" {
+			started := fmt.Sprintf("Started %s (%s)", book.StartedAt, describeDaysAgo(book.DaysReading))
+			if book.PacePerDay > 0 {
+				started += " · " + describePace(book)
+			}
+			printToStdoutf(w, "   %s\n", started)
+		}
+		printOptionalField(w, "Projected finish", book.ProjectedFinish)
+		printSearchSeparator(w)
+	}
+}
+
+// setupReadingCommands registers the reading command with the root command.
+func setupReadingCommands() {
+	rootCmd.AddCommand(readingCmd)
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// daysFromToday returns the date the given number of days from today.
+func daysFromToday(days int) string {
+	return time.Now().AddDate(0, 0, days).Format(time.DateOnly)
+}
+
+// currentlyReading is the shelf used by the reading dashboard tests.
+func currentlyReading() map[int]map[string]interface{} {
+	return map[int]map[string]interface{}{
+		328491: {
+			"id": 7, "book_id": 328491, "status_id": 2,
+			"book": map[string]interface{}{
+				"id": 328491, "title": "Dune", "slug": "dune", "pages": 617,
+				"contributions": []interface{}{map[string]interface{}{"author": map[string]interface{}{"name": "Frank Herbert"}}},
+			},
+			"user_book_reads": []interface{}{
+				map[string]interface{}{"id": 70, "started_at": "2020-01-01", "finished_at": "2020-02-01", "progress_pages": 617},
+				map[string]interface{}{"id": 71, "started_at": daysFromToday(-10), "progress_pages": 100},
+			},
+		},
+		2: {
+			"id": 8, "book_id": 2, "status_id": 2,
+			"book":    map[string]interface{}{"id": 2, "title": "Project Hail Mary"},
+			"edition": map[string]interface{}{"id": 40, "edition_format": "Audiobook", "audio_seconds": 36000},
+			"user_book_reads": []interface{}{
+				map[string]interface{}{"id": 72, "started_at": daysFromToday(0), "progress_seconds": 3600},
+			},
+		},
+		3: {
+			"id": 9, "book_id": 3, "status_id": 2,
+			"book": map[string]interface{}{"id": 3, "title": "Children of Dune"},
+		},
+	}
+}
+
+func TestReadingCmd_Dashboard(t *testing.T) {
+	library, url := newFakeLibrary(t, currentlyReading())
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, readingCmd.RunE(cmd, nil))
+
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "Currently reading (3 books)")
+	assert.Contains(t, outputStr, "1. Dune by Frank Herbert\n"+
+		"   [███░░░░░░░░░░░░░░░░░] 16% · page 100 of 617\n"+
+		"   Started "+daysFromToday(-10)+" (10 days ago) · 10.0 pages/day\n"+
+		"   Projected finish: "+daysFromToday(52)+"\n")
+	assert.Contains(t, outputStr, "2. Project Hail Mary\n"+
+		"   [██░░░░░░░░░░░░░░░░░░] 10% · 1h00m of 10h00m\n"+
+		"   Started "+daysFromToday(0)+" (today) · 1h00m/day\n"+
+		"   Projected finish: "+daysFromToday(9)+"\n")
+	assert.Contains(t, outputStr, "3. Children of Dune\n   No progress recorded yet\n")
+
+	require.Len(t, library.queries, 1)
+	where, err := json.Marshal(library.queries[0].Variables["where"])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"_and": [{"user_id": {"_eq": 42}}, {"status_id": {"_in": [2]}}]}`, string(where))
+}
+
+func TestReadingCmd_JSON(t *testing.T) {
+	_, url := newFakeLibrary(t, currentlyReading())
+	cmd, output := newBookTestCommand(url, "json")
+
+	require.NoError(t, readingCmd.RunE(cmd, nil))
+
+	var views []readingBookView
+	require.NoError(t, json.Unmarshal(output.Bytes(), &views))
+	require.Len(t, views, 3)
+	assert.Equal(t, 10, views[0].DaysReading)
+	assert.InDelta(t, 10.0, views[0].PacePerDay, 0.001)
+	assert.InDelta(t, 16.0, views[0].Percent, 0)
+	assert.Equal(t, daysFromToday(52), views[0].ProjectedFinish)
+	assert.Empty(t, views[2].ProjectedFinish)
+}
+
+func TestReadingCmd_Empty(t *testing.T) {
+	_, url := newFakeLibrary(t, nil)
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, readingCmd.RunE(cmd, nil))
+
+	assert.Equal(t, "You are not reading any books right now.\n", output.String())
+}
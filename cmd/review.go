@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// ratingStep is the granularity of ratings: half stars.
+const ratingStep = 0.5
+
+// reviewHelp heads the frontmatter of the review document opened in the
+// editor.
+const reviewHelp = `# rating: 0.5 to 5 in half-star steps; leave empty for no rating
+# spoilers: true if the review gives away the plot
+# private_notes: notes only you can see
+# Write your review in Markdown below the closing ---.
+`
+
+// reviewCmd represents the review command.
+var reviewCmd = &cobra.Command{
+	Use:   "review <book>",
+	Short: "Rate and review a book",
+	Long: `Rate and review a book, identified by Hardcover ID, URL slug or ISBN.
+
+Your editor ($VISUAL, then $EDITOR, then vi) opens a Markdown document with
+your current review below a frontmatter header:
+
+  ---
+  rating: 4.5
+  spoilers: false
+  private_notes: Borrowed from Sam.
+  ---
+  A masterpiece of world-building...
+
+The rating takes half-star steps from 0.5 to 5; leave it empty to remove
+it. Private notes are only visible to you. Saving the document unchanged
+changes nothing. If the document is invalid, nothing is saved and the
+draft is kept so you can fix it and pass it to --from-file.
+
+With --from-file the document is read from a file ("-" for standard input)
+instead. A file without a frontmatter header replaces just the review text,
+and keys left out of the header keep their current values.
+
+Books that are not in your library yet are added as Read.
+
+Example:
+  hardcover review dune
+  hardcover review 328491 --from-file review.md
+  echo "Loved it." | hardcover review dune --from-file -`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var fromFile string
+		if err := readStringFlags(cmd, map[string]*string{"from-file": &fromFile}); err != nil {
+			return err
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		book, err := resolveLibraryBook(ctx, gqlClient, args[0])
+		if err != nil {
+			return err
+		}
+		existing, err := gqlClient.GetMyUserBook(ctx, book.ID)
+		if errors.Is(err, client.ErrNotFound) {
+			existing = nil
+		} else if err != nil {
+			return fmt.Errorf("failed to get library entry: %w", err)
+		}
+
+		current := newReviewDocument(existing)
+		text, draft, err := reviewText(cmd, fromFile, current)
+		if err != nil {
+			return err
+		}
+		review, err := parseReviewDocument(text, current)
+		if err != nil {
+			if draft != "" {
+				return fmt.Errorf("%w\nYour draft is saved in %s; fix it and run again with --from-file %s", err, draft, draft)
+			}
+			return err
+		}
+
+		view, err := saveReview(ctx, gqlClient, book, existing, review)
+		if err != nil {
+			return err
+		}
+		if draft != "" {
+			_ = os.Remove(draft)
+		}
+		return render(cmd, view, func(w io.Writer) {
+			printReview(w, view)
+		})
+	},
+}
+
+// reviewDocument is a review as edited by the user: frontmatter fields and
+// the Markdown text below them.
+type reviewDocument struct {
+	Rating       *float64 `yaml:"rating"`
+	Spoilers     bool     `yaml:"spoilers"`
+	PrivateNotes string   `yaml:"private_notes"`
+	Text         string   `yaml:"-"`
+}
+
+// newReviewDocument returns the review stored on a shelf entry, which may be
+// nil for a book that is not shelved.
+func newReviewDocument(userBook *client.UserBook) *reviewDocument {
+	review := &reviewDocument{}
+	if userBook == nil {
+		return review
+	}
+	if userBook.Rating > 0 {
+		rating := userBook.Rating
+		review.Rating = &rating
+	}
+	review.Spoilers = userBook.ReviewHasSpoilers
+	review.PrivateNotes = userBook.PrivateNotes
+	review.Text = userBook.ReviewRaw
+	return review
+}
+
+// reviewText returns the review document to save: read from --from-file
+// when given, otherwise edited by the user starting from current. The second
+// value is the path of the editor's draft, if any.
+func reviewText(cmd *cobra.Command, fromFile string, current *reviewDocument) (string, string, error) {
+	switch fromFile {
+	case "":
+		document, err := formatReviewDocument(current)
+		if err != nil {
+			return "", "", err
+		}
+		return editText(document, "review-*.md")
+	case "-":
+		data, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read review: %w", err)
+		}
+		return string(data), "", nil
+	default:
+		data, err := os.ReadFile(fromFile)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read review file: %w", err)
+		}
+		return string(data), "", nil
+	}
+}
+
+// formatReviewDocument renders a review as frontmatter followed by its
+// text.
+func formatReviewDocument(review *reviewDocument) (string, error) {
+	return formatFrontmatter(reviewHelp, review, review.Text)
+}
+
+// parseReviewDocument parses and validates an edited review. Fields missing
+// from the frontmatter, or the whole frontmatter, keep their values from
+// current.
+func parseReviewDocument(text string, current *reviewDocument) (*reviewDocument, error) {
+	review := *current
+	if current.Rating != nil {
+		// Decoding writes through a non-nil pointer; keep current intact.
+		rating := *current.Rating
+		review.Rating = &rating
+	}
+
+	body, err := parseFrontmatter(text, &review)
+	if err != nil {
+		return nil, err
+	}
+	if review.Rating != nil {
+		if err := validateRating(*review.Rating); err != nil {
+			return nil, err
+		}
+	}
+	review.PrivateNotes = strings.TrimSpace(review.PrivateNotes)
+	review.Text = body
+	return &review, nil
+}
+
+// validateRating checks that a rating is a half-star step from 0.5 to 5.
+func validateRating(rating float64) error {
+	if rating < ratingStep || rating > maxRating || math.Mod(rating, ratingStep) != 0 {
+		return fmt.Errorf("invalid rating %s: use 0.5 to %d in half-star steps", formatRating(rating), maxRating)
+	}
+	return nil
+}
+
+// reviewInput returns the changes that turn current into review.
+func reviewInput(current, review *reviewDocument) *client.UserBookInput {
+	input := &client.UserBookInput{}
+	switch {
+	case review.Rating == nil:
+		input.ClearRating = current.Rating != nil
+	case current.Rating == nil || *current.Rating != *review.Rating:
+		input.Rating = review.Rating
+	}
+	if review.Spoilers != current.Spoilers {
+		input.ReviewHasSpoilers = &review.Spoilers
+	}
+	if review.Text != current.Text {
+		input.ReviewRaw = &review.Text
+		if review.Text != "" {
+			reviewedAt := time.Now().UTC().Format(time.RFC3339)
+			input.ReviewedAt = &reviewedAt
+		}
+	}
+	if review.PrivateNotes != current.PrivateNotes {
+		input.PrivateNotes = &review.PrivateNotes
+	}
+	return input
+}
+
+// saveReview stores review on the user's entry for book, adding the book as
+// Read when existing is nil.
+func saveReview(
+	ctx context.Context, c *client.Client, book *client.BookDetail, existing *client.UserBook, review *reviewDocument,
+) (*reviewView, error) {
+	input := reviewInput(newReviewDocument(existing), review)
+	view := newReviewView(book, review)
+	switch {
+	case input.IsEmpty():
+		view.Action = libraryActionUnchanged
+		if existing != nil {
+			view.UserBookID = existing.ID
+		}
+		return view, nil
+	case existing == nil:
+		input.BookID = book.ID
+		input.StatusID = client.StatusRead
+		added, err := c.AddUserBook(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add book: %w", err)
+		}
+		view.Action, view.UserBookID = libraryActionAdded, added.ID
+	default:
+		if _, err := c.UpdateUserBook(ctx, existing.ID, input); err != nil {
+			return nil, fmt.Errorf("failed to save review: %w", err)
+		}
+		view.Action, view.UserBookID = libraryActionUpdated, existing.ID
+	}
+	return view, nil
+}
+
+// reviewView is the structured form of the review command's outcome.
+type reviewView struct {
+	Action       string   `json:"action"`
+	UserBookID   int      `json:"user_book_id"`
+	BookID       int      `json:"book_id"`
+	Title        string   `json:"title"`
+	Rating       *float64 `json:"rating"`
+	Spoilers     bool     `json:"spoilers"`
+	Review       string   `json:"review"`
+	PrivateNotes string   `json:"private_notes"`
+}
+
+// newReviewView describes the review of book.
+func newReviewView(book *client.BookDetail, review *reviewDocument) *reviewView {
+	return &reviewView{
+		BookID:       book.ID,
+		Title:        book.Title,
+		Rating:       review.Rating,
+		Spoilers:     review.Spoilers,
+		Review:       review.Text,
+		PrivateNotes: review.PrivateNotes,
+	}
+}
+
+// printReview writes a summary of the saved review.
+func printReview(w io.Writer, view *reviewView) {
+	switch view.Action {
+	case libraryActionAdded:
+		printToStdoutf(w, "Added %q to your library as Read and saved your review.\n", view.Title)
+	case libraryActionUnchanged:
+		printToStdoutf(w, "Your review of %q is unchanged.\n", view.Title)
+		return
+	default:
+		printToStdoutf(w, "Saved your review of %q.\n", view.Title)
+	}
+
+	if view.Rating != nil {
+		printToStdoutf(w, "  Rating: %s/5\n", formatRating(*view.Rating))
+	}
+	if view.Review != "" {
+		printToStdoutf(w, "  Review: %s\n", pluralize(len(strings.Fields(view.Review)), "word", "words"))
+	}
+	if view.Spoilers {
+		printToStdoutf(w, "  Marked as containing spoilers\n")
+	}
+	if view.PrivateNotes != "" {
+		printToStdoutf(w, "  Private notes saved\n")
+	}
+}
+
+// addReviewFlags registers the flags of the review command.
+func addReviewFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("from-file", "f", "", "read the review document from a file (\"-\" for standard input)")
+}
+
+// setupReviewCommands registers the review command with the root command.
+func setupReviewCommands() {
+	addReviewFlags(reviewCmd)
+	rootCmd.AddCommand(reviewCmd)
+}
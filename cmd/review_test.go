@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reviewedDune is a shelf entry for Dune with a rating and a review.
+func reviewedDune() map[int]map[string]interface{} {
+	return map[int]map[string]interface{}{
+		328491: {"id": 7, "book_id": 328491, "status_id": 3, "rating": 4, "review_raw": "A classic."},
+	}
+}
+
+// stubEditor replaces the editor with edit, which receives the document
+// and returns its edited form.
+func stubEditor(t *testing.T, edit func(document string) string) {
+	t.Helper()
+
+	original := launchEditor
+	launchEditor = func(path string) error {
+		document, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(path, []byte(edit(string(document))), 0o600)
+	}
+	t.Cleanup(func() { launchEditor = original })
+}
+
+func TestReviewCmd_FromFile(t *testing.T) {
+	library, url := newFakeLibrary(t, reviewedDune())
+	path := filepath.Join(t.TempDir(), "review.md")
+	require.NoError(t, os.WriteFile(path, []byte(
+		"---\nrating: 4.5\nspoilers: true\nprivate_notes: Borrowed from Sam.\n---\n\nThe spice must flow.\n"), 0o600))
+	cmd, output := newFlagTestCommand(t, url, "text", addReviewFlags, "--from-file", path)
+
+	require.NoError(t, reviewCmd.RunE(cmd, []string{"dune"}))
+
+	assert.Equal(t, "Saved your review of \"Dune\".\n  Rating: 4.5/5\n  Review: 4 words\n"+
+		"  Marked as containing spoilers\n  Private notes saved\n", output.String())
+	require.Len(t, library.mutations, 1)
+	object := library.mutations[0].Variables["object"].(map[string]interface{})
+	assert.InDelta(t, 4.5, object["rating"], 0)
+	assert.Equal(t, true, object["review_has_spoilers"])
+	assert.Equal(t, "The spice must flow.", object["review_raw"])
+	assert.Equal(t, "Borrowed from Sam.", object["private_notes"])
+	assert.NotEmpty(t, object["reviewed_at"])
+}
+
+func TestReviewCmd_EditorClearsRating(t *testing.T) {
+	library, url := newFakeLibrary(t, reviewedDune())
+	var opened string
+	stubEditor(t, func(document string) string {
+		opened = document
+		return strings.Replace(document, "rating: 4\n", "rating:\n", 1)
+	})
+	cmd, _ := newBookTestCommand(url, "text")
+
+	require.NoError(t, reviewCmd.RunE(cmd, []string{"dune"}))
+
+	assert.True(t, strings.HasPrefix(opened, "---\n# rating: 0.5 to 5 in half-star steps"))
+	assert.Contains(t, opened, "rating: 4\nspoilers: false\nprivate_notes: \"\"\n---\n\nA classic.\n")
+	require.Len(t, library.mutations, 1)
+	assert.Equal(t, map[string]interface{}{"rating": nil}, library.mutations[0].Variables["object"])
+}
+
+func TestReviewCmd_UnchangedDocument(t *testing.T) {
+	library, url := newFakeLibrary(t, reviewedDune())
+	stubEditor(t, func(document string) string { return document })
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, reviewCmd.RunE(cmd, []string{"dune"}))
+
+	assert.Equal(t, "Your review of \"Dune\" is unchanged.\n", output.String())
+	assert.Empty(t, library.mutations)
+}
+
+func TestReviewCmd_StdinAddsUnshelvedBook(t *testing.T) {
+	library, url := newFakeLibrary(t, nil)
+	cmd, output := newFlagTestCommand(t, url, "text", addReviewFlags, "--from-file", "-")
+	cmd.SetIn(strings.NewReader("Loved it.\n"))
+
+	require.NoError(t, reviewCmd.RunE(cmd, []string{"dune"}))
+
+	assert.Contains(t, output.String(), "Added \"Dune\" to your library as Read and saved your review.\n")
+	require.Len(t, library.mutations, 1)
+	object := library.mutations[0].Variables["object"].(map[string]interface{})
+	assert.Equal(t, float64(328491), object["book_id"])
+	assert.Equal(t, float64(3), object["status_id"])
+	assert.Equal(t, "Loved it.", object["review_raw"])
+}
+
+func TestReviewCmd_InvalidDocumentKeepsDraft(t *testing.T) {
+	library, url := newFakeLibrary(t, reviewedDune())
+	stubEditor(t, func(document string) string {
+		return strings.Replace(document, "rating: 4\n", "rating: 4.25\n", 1)
+	})
+	cmd, _ := newBookTestCommand(url, "text")
+
+	err := reviewCmd.RunE(cmd, []string{"dune"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid rating 4.25: use 0.5 to 5 in half-star steps")
+	assert.Contains(t, err.Error(), "Your draft is saved in ")
+	assert.Empty(t, library.mutations)
+
+	draft := strings.Fields(err.Error()[strings.Index(err.Error(), "saved in ")+len("saved in "):])[0]
+	draft = strings.TrimSuffix(draft, ";")
+	t.Cleanup(func() { _ = os.Remove(draft) })
+	assert.FileExists(t, draft)
+}
+
+func TestParseReviewDocument_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		wantErr  string
+	}{
+		{name: "unknown key", document: "---\nstars: 4\n---\n", wantErr: "invalid frontmatter"},
+		{name: "rating out of range", document: "---\nrating: 6\n---\n", wantErr: "invalid rating 6"},
+		{name: "zero rating", document: "---\nrating: 0\n---\n", wantErr: "invalid rating 0"},
+		{name: "unclosed header", document: "---\nrating: 4\nGreat book.", wantErr: "must end with a --- line"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseReviewDocument(tt.document, &reviewDocument{})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestEditorCommand(t *testing.T) {
+	tests := []struct {
+		name   string
+		visual string
+		editor string
+		want   []string
+	}{
+		{name: "visual first", visual: "code --wait", editor: "nano", want: []string{"code", "--wait"}},
+		{name: "editor", editor: "nano", want: []string{"nano"}},
+		{name: "unset", want: []string{defaultEditor}},
+		{name: "whitespace", visual: "  ", editor: "\t", want: []string{defaultEditor}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("VISUAL", tt.visual)
+			t.Setenv("EDITOR", tt.editor)
+			assert.Equal(t, tt.want, editorCommand())
+		})
+	}
+}
@@ -2,14 +2,18 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	"hardcover-cli/internal/client"
 	"hardcover-cli/internal/config"
 	"hardcover-cli/internal/contextutil"
+	"hardcover-cli/internal/output"
 )
 
 var cfgFile string
@@ -37,10 +41,26 @@ Before using the CLI, you need to set your Hardcover.app API key:
 
 Get your API key from: https://hardcover.app/account/developer
 
+Every command accepts --output/-o to choose how results are printed:
+text (default), json, yaml, csv, tsv or table.
+
 Available Commands:
+  author    Look up authors and their books
+  book      Look up books
   config    Manage configuration settings
+  export    Export your library to a Goodreads CSV or a JSON archive
+  goals     Set and track reading goals
+  import    Import your reading history from other services
+  isbn      Look up editions and books by ISBN
+  journal   Keep a reading journal of notes and quotes
+  library   Manage the books on your shelves
+  list      Create and curate book lists
   me        Get your user profile information
+  progress  Track your reading progress
+  reading   Show the books you are currently reading
+  review    Rate and review a book
   search    Search for books and users
+  series    Look up book series
   help      Help about any command`,
 }
 
@@ -50,13 +70,31 @@ func SetupCommands() {
 	setupConfigCommands()
 	setupMeCommands()
 	setupSearchCommands()
+	setupBookCommands()
+	setupISBNCommands()
+	setupAuthorCommands()
+	setupSeriesCommands()
+	setupLibraryCommands()
+	setupReviewCommands()
+	setupProgressCommands()
+	setupReadingCommands()
+	setupJournalCommands()
+	setupGoalsCommands()
+	setupListCommands()
+	setupImportCommands()
+	setupExportCommands()
 }
 
 // Execute runs the root command.
 func Execute() {
+	SetupCommands()
+
 	err := rootCmd.Execute()
 	if err != nil {
-		os.Exit(1)
+		if hint := errorHint(err); hint != "" {
+			fmt.Fprintln(os.Stderr, hint)
+		}
+		os.Exit(exitCode(err))
 	}
 }
 
@@ -68,11 +106,26 @@ func setupRootCommand() {
 	// Cobra supports persistent flags, which, if defined here,
 	// will be global for your application.
 
-	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.hardcover/config.yaml)")
-	rootCmd.PersistentFlags().String("api-key", "", "Hardcover API key (overrides config file)")
+	addRootFlags(rootCmd)
 
-	// Run the setup commands when the package is loaded
-	SetupCommands()
+	// Reject unknown output formats before any command talks to the API
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+		_, err := outputFormat(cmd)
+		return err
+	}
+}
+
+// addRootFlags registers the global flags, which every subcommand inherits.
+func addRootFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.hardcover/config.yaml)")
+	cmd.PersistentFlags().String("api-key", "", "Hardcover API key (overrides config file)")
+	cmd.PersistentFlags().StringP("output", "o", string(output.FormatText),
+		"output format ("+strings.Join(output.Formats(), ", ")+")")
+	cmd.PersistentFlags().Int("max-retries", 0, "retries after a failed API request (overrides config file, default 3)")
+	cmd.PersistentFlags().Duration("retry-delay", 0, "wait before the first retry, doubling after each (overrides config file, default 500ms)")
+	cmd.PersistentFlags().Duration("retry-max-delay", 0, "longest wait between retries (overrides config file, default 30s)")
+	cmd.PersistentFlags().Int("rate-limit", 0, "most API requests a minute, 0 for no limit (overrides config file, default 60)")
+	cmd.PersistentFlags().Bool("verbose", false, "report waits for the rate limit and retries on standard error")
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -93,6 +146,41 @@ func initConfig() {
 	globalConfig = cfg
 }
 
+// applyRequestFlags overrides the retry and rate limit configuration with
+// the flags that were set on cmd.
+func applyRequestFlags(cmd *cobra.Command, cfg *config.Config) error {
+	flags := cmd.Flags()
+	retry := &cfg.Retry
+	var err error
+	if flags.Changed("max-retries") {
+		if retry.MaxRetries, err = flags.GetInt("max-retries"); err != nil {
+			return err
+		}
+	}
+	if flags.Changed("retry-delay") {
+		if retry.BaseDelay, err = flags.GetDuration("retry-delay"); err != nil {
+			return err
+		}
+	}
+	if flags.Changed("retry-max-delay") {
+		if retry.MaxDelay, err = flags.GetDuration("retry-max-delay"); err != nil {
+			return err
+		}
+	}
+	if flags.Changed("rate-limit") {
+		if cfg.RateLimit, err = flags.GetInt("rate-limit"); err != nil {
+			return err
+		}
+	}
+	if retry.MaxRetries < 0 || retry.BaseDelay < 0 || retry.MaxDelay < 0 {
+		return errors.New("retry settings cannot be negative")
+	}
+	if cfg.RateLimit < 0 {
+		return errors.New("the rate limit cannot be negative")
+	}
+	return nil
+}
+
 // getConfig retrieves the configuration with context support.
 func getConfig(ctx context.Context) (*config.Config, bool) {
 	// Check if context is cancelled
@@ -124,3 +212,37 @@ func getConfig(ctx context.Context) (*config.Config, bool) {
 	}
 	return nil, false
 }
+
+// newAuthenticatedClient builds an API client from the configuration in the
+// command's context, with the retry and rate limit flags set on cmd applied,
+// failing when no API key has been configured.
+func newAuthenticatedClient(cmd *cobra.Command) (*client.Client, error) {
+	resolved, ok := getConfig(cmd.Context())
+	if !ok {
+		return nil, errors.New("failed to get configuration")
+	}
+	cfg := *resolved
+	if err := applyRequestFlags(cmd, &cfg); err != nil {
+		return nil, err
+	}
+
+	if cfg.APIKey == "" {
+		return nil, errors.New("API key is required. Set it using:\n" +
+			"  export HARDCOVER_API_KEY=\"your-api-key\"\n" +
+			"  or\n" +
+			"  hardcover config set-api-key \"your-api-key\"")
+	}
+
+	options := []client.Option{
+		client.WithRetryPolicy(client.RetryPolicy{
+			MaxRetries: cfg.Retry.MaxRetries,
+			BaseDelay:  cfg.Retry.BaseDelay,
+			MaxDelay:   cfg.Retry.MaxDelay,
+		}),
+		client.WithRateLimit(cfg.RateLimit),
+	}
+	if verbose, _ := cmd.Flags().GetBool("verbose"); verbose {
+		options = append(options, client.WithVerbose(os.Stderr))
+	}
+	return client.NewClient(cfg.BaseURL, cfg.APIKey, options...), nil
+}
@@ -2,52 +2,32 @@ package cmd
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"io"
 	"strings"
 
-	"hardcover-cli/internal/client"
-
 	"github.com/spf13/cobra"
-)
-
-// Note: Using types from internal/client package instead of duplicating them here
 
-// SearchUser represents a user from the search API.
-type SearchUser struct {
-	Image              interface{} `json:"image"`
-	ID                 string      `json:"id"`
-	Username           string      `json:"username"`
-	Name               string      `json:"name"`
-	Location           string      `json:"location"`
-	Flair              string      `json:"flair"`
-	BooksCount         int         `json:"books_count"`
-	FollowersCount     int         `json:"followers_count"`
-	FollowedUsersCount int         `json:"followed_users_count"`
-	Pro                bool        `json:"pro"`
-}
-
-// SearchUsersResponse represents the response from the SearchUsers query.
-type SearchUsersResponse struct {
-	Search struct {
-		Query     string       `json:"query"`
-		QueryType string       `json:"query_type"`
-		IDs       []string     `json:"ids"`
-		Results   []SearchUser `json:"results"`
-		Page      int          `json:"page"`
-		PerPage   int          `json:"per_page"`
-	} `json:"search"`
-}
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/output"
+)
 
 // searchCmd represents the search command.
 var searchCmd = &cobra.Command{
 	Use:   "search",
 	Short: "Search for content on Hardcover.app",
-	Long: `Search for books and users on Hardcover.app.
+	Long: `Search for books, users and other content on Hardcover.app.
 	
 Available subcommands:
-  books    Search for books by title, author, or other criteria
-  users    Search for users by name, username, or location`,
+  books       Search for books by title, author, or other criteria
+  users       Search for users by name, username, or location
+  authors     Search for authors by name
+  series      Search for book series
+  lists       Search for reader-curated lists
+  characters  Search for fictional characters
+  publishers  Search for publishers
+  prompts     Search for community prompts
+  all         Search every type at once and group the results`,
 }
 
 // searchBooksCmd represents the search books command.
@@ -70,128 +50,7 @@ Example:
   hardcover search books "machine learning"`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		cfg, ok := getConfig(cmd.Context())
-		if !ok {
-			return errors.New("failed to get configuration")
-		}
-
-		if cfg.APIKey == "" {
-			return errors.New("API key is required. Set it using:\n" +
-				"  export HARDCOVER_API_KEY=\"your-api-key\"\n" +
-				"  or\n" +
-				"  hardcover config set-api-key \"your-api-key\"")
-		}
-
-		query := args[0]
-		client := client.NewClient(cfg.BaseURL, cfg.APIKey)
-
-		// Use GraphQL query as shown in the documentation
-		const gqlQuery = `
-			query SearchBooks($query: String!) {
-				search(query: $query, query_type: "Book", per_page: 25, page: 1) {
-					ids
-					results
-					query
-					query_type
-					page
-					per_page
-				}
-			}
-		`
-
-		variables := map[string]interface{}{
-			"query": query,
-		}
-
-		var response map[string]interface{}
-		if err := client.Execute(context.Background(), gqlQuery, variables, &response); err != nil {
-			return fmt.Errorf("failed to search books: %w", err)
-		}
-
-		if searchData, ok := response["search"].(map[string]interface{}); ok {
-			if resultsMap, ok := searchData["results"].(map[string]interface{}); ok {
-				if hits, ok := resultsMap["hits"].([]interface{}); ok && len(hits) > 0 {
-					for i, hit := range hits {
-						hitMap, ok := hit.(map[string]interface{})
-						if !ok {
-							continue
-						}
-						book, ok := hitMap["document"].(map[string]interface{})
-						if !ok {
-							continue
-						}
-						// Title
-						if title, ok := book["title"].(string); ok {
-							printToStdoutf(cmd.OutOrStdout(), "%d. %s\n", i+1, title)
-						}
-						// Subtitle
-						if subtitle, ok := book["subtitle"].(string); ok && subtitle != "" {
-							printToStdoutf(cmd.OutOrStdout(), "   Subtitle: %s\n", subtitle)
-						}
-						// Author names
-						if authors, ok := book["author_names"].([]interface{}); ok && len(authors) > 0 {
-							authorStrs := make([]string, 0, len(authors))
-							for _, a := range authors {
-								if s, ok := a.(string); ok {
-									authorStrs = append(authorStrs, s)
-								}
-							}
-							printToStdoutf(cmd.OutOrStdout(), "   Authors: %s\n", strings.Join(authorStrs, ", "))
-						}
-						// Year
-						if year, ok := book["release_year"].(float64); ok && year > 0 {
-							printToStdoutf(cmd.OutOrStdout(), "   Year: %.0f\n", year)
-						}
-						// Edition ID
-						if id, ok := book["id"].(string); ok {
-							printToStdoutf(cmd.OutOrStdout(), "   Edition ID: %s\n", id)
-						}
-						// Slug (URL)
-						if slug, ok := book["slug"].(string); ok && slug != "" {
-							printToStdoutf(cmd.OutOrStdout(), "   URL: https://hardcover.app/books/%s\n", slug)
-						}
-						// Rating
-						if rating, ok := book["rating"].(float64); ok && rating > 0 {
-							if ratingsCount, ok := book["ratings_count"].(float64); ok {
-								printToStdoutf(
-									cmd.OutOrStdout(),
-									"   Rating: %.2f/5 (%.0f ratings)\n",
-									rating,
-									ratingsCount,
-								)
-							}
-						}
-						// ISBNs
-						if isbns, ok := book["isbns"].([]interface{}); ok && len(isbns) > 0 {
-							isbnStrs := make([]string, 0, len(isbns))
-							for _, s := range isbns {
-								if str, ok := s.(string); ok {
-									isbnStrs = append(isbnStrs, str)
-								}
-							}
-							printToStdoutf(cmd.OutOrStdout(), "   ISBNs: %s\n", strings.Join(isbnStrs, ", "))
-						}
-						// Series names
-						if series, ok := book["series_names"].([]interface{}); ok && len(series) > 0 {
-							seriesStrs := make([]string, 0, len(series))
-							for _, s := range series {
-								if str, ok := s.(string); ok {
-									seriesStrs = append(seriesStrs, str)
-								}
-							}
-							printToStdoutf(cmd.OutOrStdout(), "   Series: %s\n", strings.Join(seriesStrs, ", "))
-						}
-						printToStdoutLn(cmd.OutOrStdout())
-						printToStdoutf(cmd.OutOrStdout(), "-----------------------------\n")
-					}
-					return nil
-				}
-			}
-			printToStdoutf(cmd.OutOrStdout(), "No results found.\n")
-			return nil
-		}
-		printToStdoutf(cmd.OutOrStdout(), "No results found or unexpected response.\n")
-		return nil
+		return runSearch(cmd, args[0], "books", printSearchBooks)
 	},
 }
 
@@ -214,121 +73,221 @@ Example:
   hardcover search users "new york"`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		cfg, ok := getConfig(cmd.Context())
-		if !ok {
-			return errors.New("failed to get configuration")
-		}
+		return runSearch(cmd, args[0], "users", printSearchUsers)
+	},
+}
 
-		if cfg.APIKey == "" {
-			return errors.New("API key is required. Set it using:\n" +
-				"  export HARDCOVER_API_KEY=\"your-api-key\"\n" +
-				"  or\n" +
-				"  hardcover config set-api-key \"your-api-key\"")
-		}
+// searchTypeCommands returns the subcommands searching a single type.
+func searchTypeCommands() []*cobra.Command {
+	return []*cobra.Command{
+		searchBooksCmd,
+		searchUsersCmd,
+		searchAuthorsCmd,
+		searchSeriesCmd,
+		searchListsCmd,
+		searchCharactersCmd,
+		searchPublishersCmd,
+		searchPromptsCmd,
+	}
+}
 
-		query := args[0]
-		client := client.NewClient(cfg.BaseURL, cfg.APIKey)
+// setupSearchCommands registers the search commands with the root command.
+func setupSearchCommands() {
+	for _, cmd := range searchTypeCommands() {
+		searchCmd.AddCommand(cmd)
+		addSearchFlags(cmd)
+	}
+	searchCmd.AddCommand(searchAllCmd)
+	addSearchAllFlags(searchAllCmd)
+	rootCmd.AddCommand(searchCmd)
+}
 
-		// Use GraphQL query for user search
-		const gqlQuery = `
-			query SearchUsers($query: String!) {
-				search(query: $query, query_type: "User", per_page: 25, page: 1) {
-					ids
-					results
-					query
-					query_type
-					page
-					per_page
-				}
-			}
-		`
+// addSearchFlags registers the paging, sorting and field selection flags
+// shared by every search subcommand.
+func addSearchFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("page", 1, "page of results to fetch")
+	cmd.Flags().Int("per-page", client.DefaultSearchPerPage, "number of results per page")
+	cmd.Flags().Bool("all", false, "fetch every page of results, starting at --page")
+	cmd.Flags().String("sort", "", "comma-separated sort fields, e.g. \"users_count:desc\" (default: relevance)")
+	cmd.Flags().String("fields", "", "comma-separated document fields to search, e.g. \"title,author_names\"")
+	cmd.Flags().String("weights", "", "comma-separated weight for each searched field, e.g. \"5,1\"")
+}
 
-		variables := map[string]interface{}{
-			"query": query,
-		}
+// searchOptions reads the paging and sorting flags, using the defaults for any flag that
+// is not registered on cmd. It also reports whether --all was given.
+func searchOptions(cmd *cobra.Command) (*client.SearchOptions, bool, error) {
+	opts := &client.SearchOptions{Page: 1, PerPage: client.DefaultSearchPerPage}
+	all := false
 
-		var response map[string]interface{}
-		if err := client.Execute(context.Background(), gqlQuery, variables, &response); err != nil {
-			return fmt.Errorf("failed to search users: %w", err)
+	if cmd.Flags().Lookup("page") != nil {
+		page, err := cmd.Flags().GetInt("page")
+		if err != nil {
+			return nil, false, err
 		}
+		opts.Page = page
+	}
+	if cmd.Flags().Lookup("per-page") != nil {
+		perPage, err := cmd.Flags().GetInt("per-page")
+		if err != nil {
+			return nil, false, err
+		}
+		opts.PerPage = perPage
+	}
+	if cmd.Flags().Lookup("all") != nil {
+		allFlag, err := cmd.Flags().GetBool("all")
+		if err != nil {
+			return nil, false, err
+		}
+		all = allFlag
+	}
+	if err := readStringFlags(cmd, map[string]*string{
+		"sort":    &opts.Sort,
+		"fields":  &opts.Fields,
+		"weights": &opts.Weights,
+	}); err != nil {
+		return nil, false, err
+	}
+
+	if opts.Page < 1 {
+		return nil, false, fmt.Errorf("--page must be at least 1, got %d", opts.Page)
+	}
+	if opts.PerPage < 1 {
+		return nil, false, fmt.Errorf("--per-page must be at least 1, got %d", opts.PerPage)
+	}
+	return opts, all, nil
+}
 
-		if searchData, ok := response["search"].(map[string]interface{}); ok {
-			if resultsMap, ok := searchData["results"].(map[string]interface{}); ok {
-				if hits, ok := resultsMap["hits"].([]interface{}); ok && len(hits) > 0 {
-					for i, hit := range hits {
-						hitMap, ok := hit.(map[string]interface{})
-						if !ok {
-							continue
-						}
-						user, ok := hitMap["document"].(map[string]interface{})
-						if !ok {
-							continue
-						}
-
-						// Username
-						if username, ok := user["username"].(string); ok {
-							printToStdoutf(cmd.OutOrStdout(), "%d. %s\n", i+1, username)
-						}
-
-						// Name
-						if name, ok := user["name"].(string); ok && name != "" {
-							printToStdoutf(cmd.OutOrStdout(), "   Name: %s\n", name)
-						}
-
-						// Location
-						if location, ok := user["location"].(string); ok && location != "" {
-							printToStdoutf(cmd.OutOrStdout(), "   Location: %s\n", location)
-						}
-
-						// Flair
-						if flair, ok := user["flair"].(string); ok && flair != "" {
-							printToStdoutf(cmd.OutOrStdout(), "   Flair: %s\n", flair)
-						}
-
-						// Books count
-						if booksCount, ok := user["books_count"].(float64); ok {
-							printToStdoutf(cmd.OutOrStdout(), "   Books: %d\n", int(booksCount))
-						}
+// runSearch searches for documents of type T and streams each page to the
+// command's output as it arrives. Only the requested page is fetched unless
+// --all is set, in which case pages are walked until the results are
+// exhausted. printDocs renders a page as text, numbering results from
+// offset+1.
+func runSearch[T client.SearchDocument](
+	cmd *cobra.Command,
+	query string,
+	noun string,
+	printDocs func(w io.Writer, docs []T, offset int),
+) error {
+	gqlClient, err := newAuthenticatedClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts, all, err := searchOptions(cmd)
+	if err != nil {
+		return err
+	}
+
+	var doc T
+	if validateErr := opts.Validate(doc.SearchType()); validateErr != nil {
+		return validateErr
+	}
+
+	stream, err := newStream(cmd)
+	if err != nil {
+		return err
+	}
+
+	offset := (opts.Page - 1) * opts.PerPage
+	found := 0
+	for results, searchErr := range client.SearchPages[T](context.Background(), gqlClient, query, opts) {
+		if searchErr != nil {
+			return fmt.Errorf("failed to search %s: %w", noun, searchErr)
+		}
 
-						// Followers count
-						if followersCount, ok := user["followers_count"].(float64); ok {
-							printToStdoutf(cmd.OutOrStdout(), "   Followers: %d\n", int(followersCount))
-						}
+		docs := results.Documents()
+		if len(docs) == 0 && stream.Items() > 0 {
+			break
+		}
+		pageOffset := offset
+		if writeErr := stream.Write(withText(docs, func(w io.Writer) {
+			printDocs(w, docs, pageOffset)
+		})); writeErr != nil {
+			return writeErr
+		}
 
-						// Following count
-						if followedUsersCount, ok := user["followed_users_count"].(float64); ok {
-							printToStdoutf(cmd.OutOrStdout(), "   Following: %d\n", int(followedUsersCount))
-						}
+		offset += len(docs)
+		found = results.Found
+		if !all {
+			break
+		}
+	}
 
-						// Pro status
-						if pro, ok := user["pro"].(bool); ok {
-							if pro {
-								printToStdoutf(cmd.OutOrStdout(), "   Pro: Yes\n")
-							}
-						}
+	if closeErr := stream.Close(); closeErr != nil {
+		return closeErr
+	}
 
-						// Image
-						if image, ok := user["image"]; ok && image != nil {
-							printToStdoutf(cmd.OutOrStdout(), "   Has Image: Yes\n")
-						}
+	if stream.Format() == output.FormatText && !all && stream.Items() > 0 && offset < found {
+		printToStdoutf(cmd.OutOrStdout(), "Showing results %d-%d of %d. Use --page %d or --all to see more.\n",
+			offset-stream.Items()+1, offset, found, opts.Page+1)
+	}
+	return nil
+}
 
-						printToStdoutLn(cmd.OutOrStdout())
-						printToStdoutf(cmd.OutOrStdout(), "-----------------------------\n")
-					}
-					return nil
-				}
-			}
-			printToStdoutf(cmd.OutOrStdout(), "No results found.\n")
-			return nil
+// printSearchBooks writes book search results as human-readable text.
+func printSearchBooks(w io.Writer, books []client.BookDocument, offset int) {
+	if len(books) == 0 {
+		printToStdoutf(w, "No results found.\n")
+		return
+	}
+
+	for i := range books {
+		book := &books[i]
+		printToStdoutf(w, "%d. %s\n", offset+i+1, book.Title)
+		if book.Subtitle != "" {
+			printToStdoutf(w, "   Subtitle: %s\n", book.Subtitle)
 		}
-		printToStdoutf(cmd.OutOrStdout(), "No results found or unexpected response.\n")
-		return nil
-	},
+		if len(book.AuthorNames) > 0 {
+			printToStdoutf(w, "   Authors: %s\n", strings.Join(book.AuthorNames, ", "))
+		}
+		if book.ReleaseYear > 0 {
+			printToStdoutf(w, "   Year: %d\n", book.ReleaseYear)
+		}
+		printToStdoutf(w, "   Edition ID: %s\n", book.ID)
+		if book.Slug != "" {
+			printToStdoutf(w, "   URL: https://hardcover.app/books/%s\n", book.Slug)
+		}
+		if book.Rating > 0 {
+			printToStdoutf(w, "   Rating: %.2f/5 (%d ratings)\n", book.Rating, book.RatingsCount)
+		}
+		if len(book.ISBNs) > 0 {
+			printToStdoutf(w, "   ISBNs: %s\n", strings.Join(book.ISBNs, ", "))
+		}
+		if len(book.SeriesNames) > 0 {
+			printToStdoutf(w, "   Series: %s\n", strings.Join(book.SeriesNames, ", "))
+		}
+		printSearchSeparator(w)
+	}
 }
 
-// setupSearchCommands registers the search commands with the root command.
-func setupSearchCommands() {
-	searchCmd.AddCommand(searchBooksCmd)
-	searchCmd.AddCommand(searchUsersCmd)
-	rootCmd.AddCommand(searchCmd)
+// printSearchUsers writes user search results as human-readable text.
+func printSearchUsers(w io.Writer, users []client.UserDocument, offset int) {
+	if len(users) == 0 {
+		printToStdoutf(w, "No results found.\n")
+		return
+	}
+
+	for i := range users {
+		user := &users[i]
+		printToStdoutf(w, "%d. %s\n", offset+i+1, user.Username)
+		if user.Name != "" {
+			printToStdoutf(w, "   Name: %s\n", user.Name)
+		}
+		if user.Location != "" {
+			printToStdoutf(w, "   Location: %s\n", user.Location)
+		}
+		if user.Flair != "" {
+			printToStdoutf(w, "   Flair: %s\n", user.Flair)
+		}
+		printToStdoutf(w, "   Books: %d\n", user.BooksCount)
+		printToStdoutf(w, "   Followers: %d\n", user.FollowersCount)
+		printToStdoutf(w, "   Following: %d\n", user.FollowedUsersCount)
+		if user.Pro {
+			printToStdoutf(w, "   Pro: Yes\n")
+		}
+		if user.Image != nil {
+			printToStdoutf(w, "   Has Image: Yes\n")
+		}
+		printSearchSeparator(w)
+	}
 }
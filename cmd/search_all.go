@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// searchAllPerType is the default number of results shown for each type.
+const searchAllPerType = 5
+
+// searchAllCmd represents the search all command.
+var searchAllCmd = &cobra.Command{
+	Use:   "all <query>",
+	Short: "Search every content type at once",
+	Long: `Search books, authors, series, lists, characters, publishers, prompts and
+users at the same time and show the top results for each type in its own
+section.
+
+The searches run concurrently. If some types fail the remaining sections are
+still shown and a warning is printed for each failure.
+
+Example:
+  hardcover search all "dune"
+  hardcover search all "tolkien" --per-page 10
+  hardcover search all "discworld" -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		perPage := searchAllPerType
+		if cmd.Flags().Lookup("per-page") != nil {
+			if perPage, err = cmd.Flags().GetInt("per-page"); err != nil {
+				return err
+			}
+		}
+		if perPage < 1 {
+			return fmt.Errorf("--per-page must be at least 1, got %d", perPage)
+		}
+
+		sections := searchAllTypes(context.Background(), gqlClient, args[0], &client.SearchOptions{PerPage: perPage})
+
+		var succeeded searchSections
+		var failures []error
+		for _, section := range sections {
+			if section.err != nil {
+				printToStdoutf(cmd.ErrOrStderr(), "Warning: failed to search %s: %v\n", section.Type, section.err)
+				failures = append(failures, fmt.Errorf("%s: %w", section.Type, section.err))
+				continue
+			}
+			succeeded = append(succeeded, section)
+		}
+		if len(succeeded) == 0 {
+			return fmt.Errorf("failed to search: %w", errors.Join(failures...))
+		}
+
+		return render(cmd, succeeded, func(w io.Writer) {
+			printSearchSections(w, succeeded, args[0])
+		})
+	},
+}
+
+// addSearchAllFlags registers the flags of the search all command.
+func addSearchAllFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("per-page", searchAllPerType, "number of results to show for each type")
+}
+
+// searchSummary is the shape shared by every result type when results of
+// several types are listed together.
+type searchSummary struct {
+	ID   string
+	Name string
+	Slug string
+}
+
+// searchSection holds the results for one query type.
+type searchSection struct {
+	Type    string      `json:"type"`
+	Found   int         `json:"found"`
+	Results interface{} `json:"results"`
+
+	title     string
+	summaries []searchSummary
+	text      func(w io.Writer)
+	err       error
+}
+
+// searchSections is the grouped output of search all.
+type searchSections []*searchSection
+
+// Columns implements output.Tabular.
+func (s searchSections) Columns() []string {
+	return []string{"type", "id", "name", "slug"}
+}
+
+// Rows implements output.Tabular.
+func (s searchSections) Rows() [][]string {
+	var rows [][]string
+	for _, section := range s {
+		for _, summary := range section.summaries {
+			rows = append(rows, []string{section.Type, summary.ID, summary.Name, summary.Slug})
+		}
+	}
+	return rows
+}
+
+// sectionSearcher runs the search for a single query type.
+type sectionSearcher func(ctx context.Context, c *client.Client, query string, opts *client.SearchOptions) *searchSection
+
+// newSectionSearcher builds a sectionSearcher for documents of type T.
+func newSectionSearcher[T client.SearchDocument](
+	key string,
+	title string,
+	printDocs func(w io.Writer, docs []T, offset int),
+	summarize func(doc *T) searchSummary,
+) sectionSearcher {
+	return func(ctx context.Context, c *client.Client, query string, opts *client.SearchOptions) *searchSection {
+		section := &searchSection{Type: key, title: title}
+
+		results, err := client.Search[T](ctx, c, query, opts)
+		if err != nil {
+			section.err = err
+			return section
+		}
+
+		docs := results.Documents()
+		section.Found = results.Found
+		section.Results = docs
+		for i := range docs {
+			section.summaries = append(section.summaries, summarize(&docs[i]))
+		}
+		section.text = func(w io.Writer) {
+			printDocs(w, docs, 0)
+		}
+		return section
+	}
+}
+
+// searchAllSearchers lists the query types searched by search all, in the
+// order their sections are shown.
+var searchAllSearchers = []sectionSearcher{
+	newSectionSearcher("books", "Books", printSearchBooks, func(doc *client.BookDocument) searchSummary {
+		return searchSummary{ID: doc.ID, Name: doc.Title, Slug: doc.Slug}
+	}),
+	newSectionSearcher("authors", "Authors", printSearchAuthors, func(doc *client.AuthorDocument) searchSummary {
+		return searchSummary{ID: doc.ID, Name: doc.Name, Slug: doc.Slug}
+	}),
+	newSectionSearcher("series", "Series", printSearchSeries, func(doc *client.SeriesDocument) searchSummary {
+		return searchSummary{ID: doc.ID, Name: doc.Name, Slug: doc.Slug}
+	}),
+	newSectionSearcher("lists", "Lists", printSearchLists, func(doc *client.ListDocument) searchSummary {
+		return searchSummary{ID: doc.ID, Name: doc.Name, Slug: doc.Slug}
+	}),
+	newSectionSearcher("characters", "Characters", printSearchCharacters,
+		func(doc *client.CharacterDocument) searchSummary {
+			return searchSummary{ID: doc.ID, Name: doc.Name, Slug: doc.Slug}
+		}),
+	newSectionSearcher("publishers", "Publishers", printSearchPublishers,
+		func(doc *client.PublisherDocument) searchSummary {
+			return searchSummary{ID: doc.ID, Name: doc.Name, Slug: doc.Slug}
+		}),
+	newSectionSearcher("prompts", "Prompts", printSearchPrompts, func(doc *client.PromptDocument) searchSummary {
+		return searchSummary{ID: doc.ID, Name: doc.Question, Slug: doc.Slug}
+	}),
+	newSectionSearcher("users", "Users", printSearchUsers, func(doc *client.UserDocument) searchSummary {
+		return searchSummary{ID: doc.ID, Name: doc.Username, Slug: doc.Username}
+	}),
+}
+
+// searchAllTypes runs every section search concurrently and returns the
+// sections in display order.
+func searchAllTypes(
+	ctx context.Context,
+	c *client.Client,
+	query string,
+	opts *client.SearchOptions,
+) []*searchSection {
+	sections := make([]*searchSection, len(searchAllSearchers))
+
+	var wg sync.WaitGroup
+	for i, search := range searchAllSearchers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sections[i] = search(ctx, c, query, opts)
+		}()
+	}
+	wg.Wait()
+
+	return sections
+}
+
+// printSearchSections writes the grouped results as human-readable text,
+// skipping types without matches.
+func printSearchSections(w io.Writer, sections searchSections, query string) {
+	printed := false
+	for _, section := range sections {
+		if len(section.summaries) == 0 {
+			continue
+		}
+		printed = true
+
+		heading := fmt.Sprintf("%s (showing %d of %d)", section.title, len(section.summaries), section.Found)
+		printToStdoutf(w, "%s\n%s\n", heading, strings.Repeat("=", len(heading)))
+		section.text(w)
+		if section.Found > len(section.summaries) {
+			printToStdoutf(w, "More results: hardcover search %s %q --all\n", section.Type, query)
+		}
+		printToStdoutLn(w)
+	}
+
+	if !printed {
+		printToStdoutf(w, "No results found.\n")
+	}
+}
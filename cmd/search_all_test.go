@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/testutil"
+)
+
+// searchAllHandler answers each query type with a single document and fails
+// Prompt searches.
+func searchAllHandler(t *testing.T) testutil.TestServerHandler {
+	t.Helper()
+
+	documents := map[string]string{
+		"Book":      `{"id": "1", "title": "Dune", "slug": "dune"}`,
+		"Author":    `{"id": "2", "name": "Frank Herbert", "slug": "frank-herbert"}`,
+		"Series":    `{"id": "3", "name": "Dune", "slug": "dune"}`,
+		"List":      `{"id": "4", "name": "Desert Planets", "slug": "desert-planets"}`,
+		"Character": `{"id": "5", "name": "Paul Atreides", "slug": "paul-atreides"}`,
+		"Publisher": `{"id": "6", "name": "Ace", "slug": "ace"}`,
+		"User":      `{"id": "7", "username": "muaddib"}`,
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req client.GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+			return
+		}
+		queryType, ok := req.Variables["query_type"].(string)
+		require.True(t, ok)
+
+		document, ok := documents[queryType]
+		if !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		found := "1"
+		if queryType == "Book" {
+			found = "40"
+		}
+		response := client.GraphQLResponse{
+			Data: json.RawMessage(`{"search": {"results": {"found": ` + found +
+				`, "hits": [{"document": ` + document + `}]}}}`),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	}
+}
+
+func TestSearchAllCmd_GroupsSections(t *testing.T) {
+	server := testutil.CreateTestServerWithHandler(searchAllHandler(t))
+	defer server.Close()
+
+	cmd, stdout := newFlagTestCommand(t, server.URL, "text", addSearchAllFlags)
+	var stderr bytes.Buffer
+	cmd.SetErr(&stderr)
+	require.NoError(t, searchAllCmd.RunE(cmd, []string{"dune"}))
+
+	outputStr := stdout.String()
+	assert.Contains(t, outputStr, "Books (showing 1 of 40)")
+	assert.Contains(t, outputStr, `More results: hardcover search books "dune" --all`)
+	assert.Contains(t, outputStr, "Authors (showing 1 of 1)")
+	assert.Contains(t, outputStr, "Paul Atreides")
+	assert.Contains(t, outputStr, "muaddib")
+	assert.NotContains(t, outputStr, "Prompts")
+	assert.Less(t, bytes.Index(stdout.Bytes(), []byte("Books")), bytes.Index(stdout.Bytes(), []byte("Authors")))
+	assert.Contains(t, stderr.String(), "Warning: failed to search prompts")
+}
+
+func TestSearchAllCmd_CSVOutput(t *testing.T) {
+	server := testutil.CreateTestServerWithHandler(searchAllHandler(t))
+	defer server.Close()
+
+	cmd, stdout := newFlagTestCommand(t, server.URL, "csv", addSearchAllFlags)
+	cmd.SetErr(io.Discard)
+	require.NoError(t, searchAllCmd.RunE(cmd, []string{"dune"}))
+
+	assert.Equal(t,
+		"type,id,name,slug\n"+
+			"books,1,Dune,dune\n"+
+			"authors,2,Frank Herbert,frank-herbert\n"+
+			"series,3,Dune,dune\n"+
+			"lists,4,Desert Planets,desert-planets\n"+
+			"characters,5,Paul Atreides,paul-atreides\n"+
+			"publishers,6,Ace,ace\n"+
+			"users,7,muaddib,muaddib\n",
+		stdout.String())
+}
+
+func TestSearchAllCmd_AllTypesFail(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.HTTPErrorResponse(http.StatusInternalServerError, "down"))
+	defer server.Close()
+
+	cmd, _ := newFlagTestCommand(t, server.URL, "json", addSearchAllFlags)
+	cmd.SetErr(io.Discard)
+	err := searchAllCmd.RunE(cmd, []string{"dune"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to search")
+}
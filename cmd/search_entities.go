@@ -0,0 +1,281 @@
+package cmd
+
+import (
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// searchAuthorsCmd represents the search authors command.
+var searchAuthorsCmd = &cobra.Command{
+	Use:   "authors <query>",
+	Short: "Search for authors",
+	Long: `Search for authors by name or alternate name.
+
+The search will return matching authors with their:
+- Name and alternate names
+- Number of books
+- Series they have written
+- Author ID and URL
+
+Example:
+  hardcover search authors "tolkien"
+  hardcover search authors "ursula le guin"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSearch(cmd, args[0], "authors", printSearchAuthors)
+	},
+}
+
+// searchSeriesCmd represents the search series command.
+var searchSeriesCmd = &cobra.Command{
+	Use:   "series <query>",
+	Short: "Search for series",
+	Long: `Search for book series by name or author.
+
+The search will return matching series with their:
+- Name and author
+- Number of books and primary books
+- Readers count
+- Series ID and URL
+
+Example:
+  hardcover search series "discworld"
+  hardcover search series "the expanse"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSearch(cmd, args[0], "series", printSearchSeries)
+	},
+}
+
+// searchListsCmd represents the search lists command.
+var searchListsCmd = &cobra.Command{
+	Use:   "lists <query>",
+	Short: "Search for lists",
+	Long: `Search for reader-curated lists by name or description.
+
+The search will return matching lists with their:
+- Name, description and owner
+- Number of books and likes
+- List ID and URL
+
+Example:
+  hardcover search lists "best fantasy"
+  hardcover search lists "booker prize"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSearch(cmd, args[0], "lists", printSearchLists)
+	},
+}
+
+// searchCharactersCmd represents the search characters command.
+var searchCharactersCmd = &cobra.Command{
+	Use:   "characters <query>",
+	Short: "Search for characters",
+	Long: `Search for fictional characters by name.
+
+The search will return matching characters with their:
+- Name
+- Authors who wrote them
+- Number of books they appear in
+- Character ID and URL
+
+Example:
+  hardcover search characters "hermione"
+  hardcover search characters "sherlock holmes"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSearch(cmd, args[0], "characters", printSearchCharacters)
+	},
+}
+
+// searchPublishersCmd represents the search publishers command.
+var searchPublishersCmd = &cobra.Command{
+	Use:   "publishers <query>",
+	Short: "Search for publishers",
+	Long: `Search for publishers by name.
+
+The search will return matching publishers with their:
+- Name
+- Number of books and editions
+- Publisher ID and URL
+
+Example:
+  hardcover search publishers "penguin"
+  hardcover search publishers "tor"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSearch(cmd, args[0], "publishers", printSearchPublishers)
+	},
+}
+
+// searchPromptsCmd represents the search prompts command.
+var searchPromptsCmd = &cobra.Command{
+	Use:   "prompts <query>",
+	Short: "Search for prompts",
+	Long: `Search for community prompts such as "What book made you cry?".
+
+The search will return matching prompts with their:
+- Question and author
+- Number of answers, books and participating users
+- Prompt ID and URL
+
+Example:
+  hardcover search prompts "cry"
+  hardcover search prompts "comfort read"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSearch(cmd, args[0], "prompts", printSearchPrompts)
+	},
+}
+
+// printSearchAuthors writes author search results as human-readable text.
+func printSearchAuthors(w io.Writer, authors []client.AuthorDocument, offset int) {
+	if len(authors) == 0 {
+		printToStdoutf(w, "No results found.\n")
+		return
+	}
+
+	for i := range authors {
+		author := &authors[i]
+		printToStdoutf(w, "%d. %s\n", offset+i+1, author.Name)
+		if len(author.AlternateNames) > 0 {
+			printToStdoutf(w, "   Also known as: %s\n", strings.Join(author.AlternateNames, ", "))
+		}
+		printToStdoutf(w, "   Books: %d\n", author.BooksCount)
+		if len(author.SeriesNames) > 0 {
+			printToStdoutf(w, "   Series: %s\n", strings.Join(author.SeriesNames, ", "))
+		}
+		printToStdoutf(w, "   Author ID: %s\n", author.ID)
+		if author.Slug != "" {
+			printToStdoutf(w, "   URL: https://hardcover.app/authors/%s\n", author.Slug)
+		}
+		printSearchSeparator(w)
+	}
+}
+
+// printSearchSeries writes series search results as human-readable text.
+func printSearchSeries(w io.Writer, series []client.SeriesDocument, offset int) {
+	if len(series) == 0 {
+		printToStdoutf(w, "No results found.\n")
+		return
+	}
+
+	for i := range series {
+		s := &series[i]
+		printToStdoutf(w, "%d. %s\n", offset+i+1, s.Name)
+		if s.AuthorName != "" {
+			printToStdoutf(w, "   Author: %s\n", s.AuthorName)
+		}
+		printToStdoutf(w, "   Books: %d (%d primary)\n", s.BooksCount, s.PrimaryBooksCount)
+		if s.ReadersCount > 0 {
+			printToStdoutf(w, "   Readers: %d\n", s.ReadersCount)
+		}
+		printToStdoutf(w, "   Series ID: %s\n", s.ID)
+		if s.Slug != "" {
+			printToStdoutf(w, "   URL: https://hardcover.app/series/%s\n", s.Slug)
+		}
+		printSearchSeparator(w)
+	}
+}
+
+// printSearchLists writes list search results as human-readable text.
+func printSearchLists(w io.Writer, lists []client.ListDocument, offset int) {
+	if len(lists) == 0 {
+		printToStdoutf(w, "No results found.\n")
+		return
+	}
+
+	for i := range lists {
+		list := &lists[i]
+		printToStdoutf(w, "%d. %s\n", offset+i+1, list.Name)
+		if list.User != nil && list.User.Username != "" {
+			printToStdoutf(w, "   By: @%s\n", list.User.Username)
+		}
+		if list.Description != "" {
+			printToStdoutf(w, "   Description: %s\n", list.Description)
+		}
+		printToStdoutf(w, "   Books: %d\n", list.BooksCount)
+		printToStdoutf(w, "   Likes: %d\n", list.LikesCount)
+		printToStdoutf(w, "   List ID: %s\n", list.ID)
+		if list.Slug != "" && list.User != nil && list.User.Username != "" {
+			printToStdoutf(w, "   URL: https://hardcover.app/@%s/lists/%s\n", list.User.Username, list.Slug)
+		}
+		printSearchSeparator(w)
+	}
+}
+
+// printSearchCharacters writes character search results as human-readable text.
+func printSearchCharacters(w io.Writer, characters []client.CharacterDocument, offset int) {
+	if len(characters) == 0 {
+		printToStdoutf(w, "No results found.\n")
+		return
+	}
+
+	for i := range characters {
+		character := &characters[i]
+		printToStdoutf(w, "%d. %s\n", offset+i+1, character.Name)
+		if len(character.AuthorNames) > 0 {
+			printToStdoutf(w, "   Authors: %s\n", strings.Join(character.AuthorNames, ", "))
+		}
+		printToStdoutf(w, "   Books: %d\n", character.BooksCount)
+		printToStdoutf(w, "   Character ID: %s\n", character.ID)
+		if character.Slug != "" {
+			printToStdoutf(w, "   URL: https://hardcover.app/characters/%s\n", character.Slug)
+		}
+		printSearchSeparator(w)
+	}
+}
+
+// printSearchPublishers writes publisher search results as human-readable text.
+func printSearchPublishers(w io.Writer, publishers []client.PublisherDocument, offset int) {
+	if len(publishers) == 0 {
+		printToStdoutf(w, "No results found.\n")
+		return
+	}
+
+	for i := range publishers {
+		publisher := &publishers[i]
+		printToStdoutf(w, "%d. %s\n", offset+i+1, publisher.Name)
+		printToStdoutf(w, "   Books: %d\n", publisher.BooksCount)
+		printToStdoutf(w, "   Editions: %d\n", publisher.EditionsCount)
+		printToStdoutf(w, "   Publisher ID: %s\n", publisher.ID)
+		if publisher.Slug != "" {
+			printToStdoutf(w, "   URL: https://hardcover.app/publishers/%s\n", publisher.Slug)
+		}
+		printSearchSeparator(w)
+	}
+}
+
+// printSearchPrompts writes prompt search results as human-readable text.
+func printSearchPrompts(w io.Writer, prompts []client.PromptDocument, offset int) {
+	if len(prompts) == 0 {
+		printToStdoutf(w, "No results found.\n")
+		return
+	}
+
+	for i := range prompts {
+		prompt := &prompts[i]
+		printToStdoutf(w, "%d. %s\n", offset+i+1, prompt.Question)
+		if prompt.User != nil && prompt.User.Username != "" {
+			printToStdoutf(w, "   Asked by: @%s\n", prompt.User.Username)
+		}
+		printToStdoutf(w, "   Answers: %d\n", prompt.AnswersCount)
+		printToStdoutf(w, "   Books: %d\n", prompt.BooksCount)
+		printToStdoutf(w, "   Users: %d\n", prompt.UsersCount)
+		printToStdoutf(w, "   Prompt ID: %s\n", prompt.ID)
+		if prompt.Slug != "" {
+			printToStdoutf(w, "   URL: https://hardcover.app/prompts/%s\n", prompt.Slug)
+		}
+		printSearchSeparator(w)
+	}
+}
+
+// printSearchSeparator ends a search result entry.
+func printSearchSeparator(w io.Writer) {
+	printToStdoutLn(w)
+	printToStdoutf(w, "-----------------------------\n")
+}
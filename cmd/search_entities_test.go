@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/testutil"
+)
+
+func TestSearchAuthorsCmd_Success(t *testing.T) {
+	searchData := map[string]interface{}{
+		"search": map[string]interface{}{
+			"results": map[string]interface{}{
+				"found": 1,
+				"hits": []interface{}{
+					map[string]interface{}{
+						"document": map[string]interface{}{
+							"id":              "80626",
+							"name":            "J.R.R. Tolkien",
+							"slug":            "j-r-r-tolkien",
+							"alternate_names": []interface{}{"John Ronald Reuel Tolkien"},
+							"books_count":     512,
+							"series_names":    []interface{}{"The Lord of the Rings"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(searchData))
+	defer server.Close()
+
+	cfg := testutil.SetupTestConfig(&testutil.TestConfig{
+		APIKey:  "test-api-key",
+		BaseURL: server.URL,
+	})
+
+	ctx := testutil.WithTestConfigAdapter(context.Background(), cfg)
+	searchAuthorsCmd.SetContext(ctx)
+
+	var output bytes.Buffer
+	searchAuthorsCmd.SetOut(&output)
+
+	err := searchAuthorsCmd.RunE(searchAuthorsCmd, []string{"tolkien"})
+	require.NoError(t, err)
+
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "1. J.R.R. Tolkien")
+	assert.Contains(t, outputStr, "Also known as: John Ronald Reuel Tolkien")
+	assert.Contains(t, outputStr, "Books: 512")
+	assert.Contains(t, outputStr, "Series: The Lord of the Rings")
+	assert.Contains(t, outputStr, "https://hardcover.app/authors/j-r-r-tolkien")
+}
+
+func TestSearchListsCmd_Success(t *testing.T) {
+	searchData := map[string]interface{}{
+		"search": map[string]interface{}{
+			"results": map[string]interface{}{
+				"hits": []interface{}{
+					map[string]interface{}{
+						"document": map[string]interface{}{
+							"id":          "17",
+							"name":        "Best Fantasy",
+							"slug":        "best-fantasy",
+							"books_count": 40,
+							"likes_count": 12,
+							"user":        map[string]interface{}{"id": 9, "username": "reader"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(searchData))
+	defer server.Close()
+
+	cfg := testutil.SetupTestConfig(&testutil.TestConfig{
+		APIKey:  "test-api-key",
+		BaseURL: server.URL,
+	})
+
+	ctx := testutil.WithTestConfigAdapter(context.Background(), cfg)
+	searchListsCmd.SetContext(ctx)
+
+	var output bytes.Buffer
+	searchListsCmd.SetOut(&output)
+
+	err := searchListsCmd.RunE(searchListsCmd, []string{"fantasy"})
+	require.NoError(t, err)
+
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "1. Best Fantasy")
+	assert.Contains(t, outputStr, "By: @reader")
+	assert.Contains(t, outputStr, "Likes: 12")
+	assert.Contains(t, outputStr, "https://hardcover.app/@reader/lists/best-fantasy")
+}
+
+func TestSearchTypeCommands(t *testing.T) {
+	names := make([]string, 0, len(searchTypeCommands()))
+	for _, cmd := range searchTypeCommands() {
+		names = append(names, cmd.Name())
+	}
+	assert.Equal(t, []string{"books", "users", "authors", "series", "lists", "characters", "publishers", "prompts"}, names)
+}
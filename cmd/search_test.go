@@ -3,12 +3,16 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"hardcover-cli/internal/client"
 	"hardcover-cli/internal/testutil"
 )
 
@@ -257,3 +261,174 @@ func TestSearchCmd_Integration(t *testing.T) {
 	}
 	assert.True(t, found, "search command should be registered with root command")
 }
+
+func TestSearchBooksCmd_CSVOutput(t *testing.T) {
+	searchData := map[string]interface{}{
+		"search": map[string]interface{}{
+			"results": map[string]interface{}{
+				"hits": []interface{}{
+					map[string]interface{}{
+						"document": map[string]interface{}{
+							"id":           "book1",
+							"title":        "Go Programming Language",
+							"author_names": []interface{}{"Alan Donovan", "Brian Kernighan"},
+							"release_year": 2015,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(searchData))
+	defer server.Close()
+
+	cfg := testutil.SetupTestConfig(&testutil.TestConfig{
+		APIKey:  "test-api-key",
+		BaseURL: server.URL,
+	})
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("output", "csv", "")
+	cmd.SetContext(testutil.WithTestConfigAdapter(context.Background(), cfg))
+	var output bytes.Buffer
+	cmd.SetOut(&output)
+
+	err := searchBooksCmd.RunE(cmd, []string{"golang"})
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"id,title,subtitle,author_names,release_year,slug,rating,ratings_count,users_count,pages,isbns,"+
+			"series_names,genres,moods,has_audiobook,has_ebook,compilation,description,"+
+			"image.url,image.width,image.height,image.color\n"+
+			"book1,Go Programming Language,,Alan Donovan; Brian Kernighan,2015,,0,0,0,0,,,,,false,false,false,,,,,\n",
+		output.String())
+}
+
+func TestSearchUsersCmd_Success(t *testing.T) {
+	searchData := map[string]interface{}{
+		"search": map[string]interface{}{
+			"results": map[string]interface{}{
+				"found": 1,
+				"hits": []interface{}{
+					map[string]interface{}{
+						"document": map[string]interface{}{
+							"id":              "42",
+							"username":        "adam",
+							"name":            "Adam Fields",
+							"location":        "New York",
+							"books_count":     120,
+							"followers_count": 7,
+							"pro":             true,
+							"image":           map[string]interface{}{"url": "https://example.com/a.png"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(searchData))
+	defer server.Close()
+
+	cfg := testutil.SetupTestConfig(&testutil.TestConfig{
+		APIKey:  "test-api-key",
+		BaseURL: server.URL,
+	})
+
+	ctx := testutil.WithTestConfigAdapter(context.Background(), cfg)
+	searchUsersCmd.SetContext(ctx)
+
+	var output bytes.Buffer
+	searchUsersCmd.SetOut(&output)
+
+	err := searchUsersCmd.RunE(searchUsersCmd, []string{"adam"})
+	require.NoError(t, err)
+
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "1. adam")
+	assert.Contains(t, outputStr, "Name: Adam Fields")
+	assert.Contains(t, outputStr, "Location: New York")
+	assert.Contains(t, outputStr, "Books: 120")
+	assert.Contains(t, outputStr, "Followers: 7")
+	assert.Contains(t, outputStr, "Pro: Yes")
+	assert.Contains(t, outputStr, "Has Image: Yes")
+}
+
+// pagedSearchServer serves two pages of book results for a search finding
+// three books with two per page.
+func pagedSearchServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return testutil.CreateTestServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req client.GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+			return
+		}
+
+		hits := `[{"document": {"id": "1", "title": "Book One"}}, {"document": {"id": "2", "title": "Book Two"}}]`
+		if req.Variables["page"] == float64(2) {
+			hits = `[{"document": {"id": "3", "title": "Book Three"}}]`
+		}
+		response := client.GraphQLResponse{
+			Data: json.RawMessage(`{"search": {"results": {"found": 3, "hits": ` + hits + `}}}`),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	})
+}
+
+func TestSearchBooksCmd_AllPagesJSON(t *testing.T) {
+	server := pagedSearchServer(t)
+	defer server.Close()
+
+	cmd, output := newFlagTestCommand(t, server.URL, "json", addSearchFlags, "--all", "--per-page", "2")
+	require.NoError(t, searchBooksCmd.RunE(cmd, []string{"book"}))
+
+	var books []client.BookDocument
+	require.NoError(t, json.Unmarshal(output.Bytes(), &books))
+	require.Len(t, books, 3)
+	assert.Equal(t, "Book Three", books[2].Title)
+}
+
+func TestSearchBooksCmd_PageFooter(t *testing.T) {
+	server := pagedSearchServer(t)
+	defer server.Close()
+
+	cmd, output := newFlagTestCommand(t, server.URL, "text", addSearchFlags, "--per-page", "2")
+	require.NoError(t, searchBooksCmd.RunE(cmd, []string{"book"}))
+
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "1. Book One")
+	assert.Contains(t, outputStr, "2. Book Two")
+	assert.NotContains(t, outputStr, "Book Three")
+	assert.Contains(t, outputStr, "Showing results 1-2 of 3. Use --page 2 or --all to see more.")
+}
+
+func TestSearchBooksCmd_SecondPageNumbering(t *testing.T) {
+	server := pagedSearchServer(t)
+	defer server.Close()
+
+	cmd, output := newFlagTestCommand(t, server.URL, "text", addSearchFlags, "--page", "2", "--per-page", "2")
+	require.NoError(t, searchBooksCmd.RunE(cmd, []string{"book"}))
+
+	assert.Contains(t, output.String(), "3. Book Three")
+	assert.NotContains(t, output.String(), "Showing results")
+}
+
+func TestSearchBooksCmd_InvalidPage(t *testing.T) {
+	cmd, _ := newFlagTestCommand(t, "http://unused.invalid", "text", addSearchFlags, "--page", "0")
+	err := searchBooksCmd.RunE(cmd, []string{"book"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--page must be at least 1")
+}
+
+func TestSearchBooksCmd_InvalidSortField(t *testing.T) {
+	cmd, _ := newFlagTestCommand(t, "http://unused.invalid", "text", addSearchFlags, "--sort", "ratting:desc")
+	err := searchBooksCmd.RunE(cmd, []string{"book"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `did you mean "rating"?`)
+}
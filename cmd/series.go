@@ -0,0 +1,298 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"hardcover-cli/internal/client"
+)
+
+// Kinds of series entries.
+const (
+	seriesKindPrimary     = "primary"
+	seriesKindNovella     = "novella"
+	seriesKindCompilation = "compilation"
+	seriesKindUnnumbered  = "unnumbered"
+)
+
+// seriesCmd represents the series command.
+var seriesCmd = &cobra.Command{
+	Use:   "series",
+	Short: "Look up book series",
+	Long: `Commands for looking up book series in the Hardcover database.
+
+Available subcommands:
+  show    Show a series' books in reading order`,
+}
+
+// seriesShowCmd represents the series show command.
+var seriesShowCmd = &cobra.Command{
+	Use:   "show <id|slug>",
+	Short: "Show a series' books in reading order",
+	Long: `Show a series, identified by Hardcover ID or URL slug, with its books in
+reading order.
+
+Novellas and short stories sit at fractional positions (e.g. #2.5) between
+the main books; compilations and unnumbered entries are listed after them.
+Each book is marked with its status on your shelves (Read, Currently
+Reading, ...) and the next book you have not read is highlighted.
+
+Flags:
+  --primary-only    Only list the main books, hiding novellas, compilations
+                    and unnumbered entries
+
+Example:
+  hardcover series show 997
+  hardcover series show the-stormlight-archive
+  hardcover series show the-stormlight-archive --primary-only -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var primaryOnly bool
+		if err := readBoolFlags(cmd, map[string]*bool{"primary-only": &primaryOnly}); err != nil {
+			return err
+		}
+
+		gqlClient, err := newAuthenticatedClient(cmd)
+		if err != nil {
+			return err
+		}
+
+		var series *client.SeriesDetail
+		if id, atoiErr := strconv.Atoi(args[0]); atoiErr == nil {
+			series, err = gqlClient.GetSeries(context.Background(), id)
+		} else {
+			series, err = gqlClient.GetSeriesBySlug(context.Background(), args[0])
+		}
+		if errors.Is(err, client.ErrNotFound) {
+			return fmt.Errorf("series %q %w", args[0], client.ErrNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get series: %w", err)
+		}
+
+		// The series is still worth showing when the shelf lookup fails
+		statuses, err := gqlClient.GetMyUserBooks(context.Background(), series.BookIDs())
+		if err != nil {
+			printToStdoutf(cmd.ErrOrStderr(), "Warning: failed to get your reading statuses: %v\n", err)
+		}
+
+		view := newSeriesView(series, statuses, primaryOnly)
+		return render(cmd, view, func(w io.Writer) {
+			printSeriesView(w, view)
+		})
+	},
+}
+
+// seriesView is the structured form of the series show command's output.
+type seriesView struct {
+	ID                int              `json:"id"`
+	Name              string           `json:"name"`
+	Slug              string           `json:"slug"`
+	URL               string           `json:"url"`
+	Author            string           `json:"author"`
+	Description       string           `json:"description"`
+	BooksCount        int              `json:"books_count"`
+	PrimaryBooksCount int              `json:"primary_books_count"`
+	Completed         *bool            `json:"completed"`
+	NextUnread        *seriesBookView  `json:"next_unread"`
+	Books             []seriesBookView `json:"books"`
+}
+
+// seriesBookView is a book in the series show command's output. Status is
+// empty when the book is not on the user's shelves.
+type seriesBookView struct {
+	Position    *float64 `json:"position"`
+	Details     string   `json:"details"`
+	Kind        string   `json:"kind"`
+	ID          int      `json:"id"`
+	Title       string   `json:"title"`
+	ReleaseYear int      `json:"release_year"`
+	Pages       int      `json:"pages"`
+	Rating      float64  `json:"rating"`
+	Status      string   `json:"status"`
+	URL         string   `json:"url"`
+}
+
+// newSeriesView converts a client series into its output representation,
+// marking each book with the user's status from statuses. The next unread
+// book is only picked when statuses is non-nil, i.e. the lookup succeeded.
+func newSeriesView(series *client.SeriesDetail, statuses map[int]client.UserBook, primaryOnly bool) *seriesView {
+	view := &seriesView{
+		ID:                series.ID,
+		Name:              series.Name,
+		Slug:              series.Slug,
+		Description:       strings.TrimSpace(series.Description),
+		BooksCount:        series.BooksCount,
+		PrimaryBooksCount: series.PrimaryBooksCount,
+		Completed:         series.IsCompleted,
+		Books:             make([]seriesBookView, 0, len(series.BookSeries)),
+	}
+	if series.Slug != "" {
+		view.URL = "https://hardcover.app/series/" + series.Slug
+	}
+	if series.Author != nil {
+		view.Author = series.Author.Name
+	}
+
+	nextUnread := -1
+	for i := range series.BookSeries {
+		entry := &series.BookSeries[i]
+		if entry.Book == nil || (primaryOnly && !entry.IsPrimary()) {
+			continue
+		}
+		book := newSeriesBookView(entry)
+		status, shelved := statuses[entry.Book.ID]
+		if shelved {
+			book.Status = status.StatusID.String()
+		}
+		if nextUnread < 0 && statuses != nil && isUnread(&book, status, shelved) {
+			nextUnread = len(view.Books)
+		}
+		view.Books = append(view.Books, book)
+	}
+	if nextUnread >= 0 {
+		view.NextUnread = &view.Books[nextUnread]
+	}
+
+	return view
+}
+
+// newSeriesBookView converts a series entry into its output representation.
+func newSeriesBookView(entry *client.SeriesBookEntry) seriesBookView {
+	book := seriesBookView{
+		Position:    entry.Position,
+		Details:     entry.Details,
+		Kind:        seriesEntryKind(entry),
+		ID:          entry.Book.ID,
+		Title:       entry.Book.Title,
+		ReleaseYear: entry.Book.ReleaseYear,
+		Pages:       entry.Book.Pages,
+		Rating:      entry.Book.Rating,
+	}
+	if entry.Book.Slug != "" {
+		book.URL = "https://hardcover.app/books/" + entry.Book.Slug
+	}
+	return book
+}
+
+// seriesEntryKind classifies a series entry as a main book, a novella at a
+// fractional position, a compilation or an unnumbered entry.
+func seriesEntryKind(entry *client.SeriesBookEntry) string {
+	switch {
+	case entry.IsPrimary():
+		return seriesKindPrimary
+	case entry.Book.Compilation:
+		return seriesKindCompilation
+	case entry.Position == nil:
+		return seriesKindUnnumbered
+	default:
+		return seriesKindNovella
+	}
+}
+
+// isUnread reports whether a numbered book in the series is still to be
+// read: it is not shelved, or shelved but not read, abandoned or ignored.
+func isUnread(book *seriesBookView, entry client.UserBook, shelved bool) bool {
+	if book.Kind != seriesKindPrimary && book.Kind != seriesKindNovella {
+		return false
+	}
+	if !shelved {
+		return true
+	}
+	done := []client.UserBookStatus{client.StatusRead, client.StatusDidNotFinish, client.StatusIgnored}
+	return !slices.Contains(done, entry.StatusID)
+}
+
+// printSeriesView writes the human-readable series with its reading order.
+func printSeriesView(w io.Writer, series *seriesView) {
+	printToStdoutf(w, "%s\n", series.Name)
+	if series.Author != "" {
+		printToStdoutf(w, "by %s\n", series.Author)
+	}
+	printToStdoutLn(w)
+
+	printToStdoutf(w, "  Books: %d (%d main)\n", series.BooksCount, series.PrimaryBooksCount)
+	if series.Completed != nil {
+		state := "Ongoing"
+		if *series.Completed {
+			state = "Completed"
+		}
+		printToStdoutf(w, "  Status: %s\n", state)
+	}
+	printToStdoutf(w, "  Series ID: %d\n", series.ID)
+	if series.URL != "" {
+		printToStdoutf(w, "  URL: %s\n", series.URL)
+	}
+
+	if series.Description != "" {
+		printToStdoutLn(w)
+		printToStdoutf(w, "Description:\n%s\n", series.Description)
+	}
+
+	printToStdoutLn(w)
+	if len(series.Books) == 0 {
+		printToStdoutf(w, "No books found.\n")
+		return
+	}
+	printToStdoutf(w, "Reading order:\n")
+	for i := range series.Books {
+		printSeriesBook(w, &series.Books[i], series.NextUnread)
+	}
+	if series.NextUnread != nil {
+		printToStdoutLn(w)
+		printToStdoutf(w, "Next up: %s (%s)\n", series.NextUnread.Title, formatSeriesEntryPosition(series.NextUnread))
+	}
+}
+
+// printSeriesBook writes one line of the reading order, marking the next
+// unread book with an arrow.
+func printSeriesBook(w io.Writer, book, nextUnread *seriesBookView) {
+	marker := " "
+	if book == nextUnread {
+		marker = "→"
+	}
+
+	line := fmt.Sprintf("%s %-6s %s", marker, formatSeriesEntryPosition(book), book.Title)
+	if book.ReleaseYear > 0 {
+		line += fmt.Sprintf(" (%d)", book.ReleaseYear)
+	}
+	if book.Kind != seriesKindPrimary && book.Kind != seriesKindUnnumbered {
+		line += " · " + book.Kind
+	}
+	if book.Status != "" {
+		line += " [" + book.Status + "]"
+	}
+	printToStdoutf(w, "%s\n", line)
+}
+
+// formatSeriesEntryPosition formats a book's place in the series as "#2.5",
+// falling back to the free-form details or "-" for unnumbered entries.
+func formatSeriesEntryPosition(book *seriesBookView) string {
+	switch {
+	case book.Position != nil:
+		return "#" + strconv.FormatFloat(*book.Position, 'f', -1, 64)
+	case book.Details != "":
+		return book.Details
+	default:
+		return "-"
+	}
+}
+
+// addSeriesShowFlags registers the flags of the series show command.
+func addSeriesShowFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("primary-only", false, "only list the main books, hiding novellas, compilations and unnumbered entries")
+}
+
+// setupSeriesCommands registers the series commands with the root command.
+func setupSeriesCommands() {
+	addSeriesShowFlags(seriesShowCmd)
+	seriesCmd.AddCommand(seriesShowCmd)
+	rootCmd.AddCommand(seriesCmd)
+}
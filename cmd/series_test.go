@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/testutil"
+)
+
+// testSeries is the series returned by the series show tests.
+var testSeries = map[string]interface{}{
+	"id":                  997,
+	"name":                "The Stormlight Archive",
+	"slug":                "the-stormlight-archive",
+	"description":         "Epic fantasy on Roshar.",
+	"books_count":         6,
+	"primary_books_count": 3,
+	"is_completed":        false,
+	"author":              map[string]interface{}{"id": 204214, "name": "Brandon Sanderson", "slug": "brandon-sanderson"},
+	"book_series": []interface{}{
+		map[string]interface{}{"position": 1, "book": map[string]interface{}{"id": 1, "title": "The Way of Kings", "release_year": 2010}},
+		map[string]interface{}{"position": 2, "book": map[string]interface{}{"id": 2, "title": "Words of Radiance", "release_year": 2014}},
+		map[string]interface{}{"position": 2.5, "book": map[string]interface{}{"id": 3, "title": "Edgedancer", "release_year": 2016}},
+		map[string]interface{}{"position": 3, "book": map[string]interface{}{"id": 4, "title": "Oathbringer", "release_year": 2017}},
+		map[string]interface{}{"position": nil, "details": "1-2", "book": map[string]interface{}{
+			"id": 5, "title": "The Stormlight Archive Box Set", "compilation": true,
+		}},
+		map[string]interface{}{"position": nil, "book": map[string]interface{}{"id": 6, "title": "The Way of Kings Prime"}},
+	},
+}
+
+// seriesRequestServer serves testSeries and the given shelf entries,
+// recording the variables of the status lookup.
+func seriesRequestServer(t *testing.T, userBooks []interface{}, statusVariables *map[string]interface{}) string {
+	t.Helper()
+
+	server := testutil.CreateTestServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req client.GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+			return
+		}
+
+		var data interface{}
+		switch {
+		case strings.Contains(req.Query, "query GetSeries("):
+			data = map[string]interface{}{"series": []interface{}{testSeries}}
+		case strings.Contains(req.Query, "query GetMyUserBooks("):
+			*statusVariables = req.Variables
+			if userBooks == nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			data = map[string]interface{}{"me": []interface{}{map[string]interface{}{"user_books": userBooks}}}
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{"data": data}); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	})
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestSeriesShowCmd_ReadingOrderWithStatuses(t *testing.T) {
+	var statusVariables map[string]interface{}
+	url := seriesRequestServer(t, []interface{}{
+		map[string]interface{}{"id": 11, "book_id": 1, "status_id": 3},
+		map[string]interface{}{"id": 12, "book_id": 2, "status_id": 3},
+		map[string]interface{}{"id": 13, "book_id": 3, "status_id": 1},
+	}, &statusVariables)
+	cmd, output := newBookTestCommand(url, "text")
+
+	require.NoError(t, seriesShowCmd.RunE(cmd, []string{"the-stormlight-archive"}))
+
+	outputStr := output.String()
+	assert.Contains(t, outputStr, "The Stormlight Archive\nby Brandon Sanderson\n")
+	assert.Contains(t, outputStr, "Books: 6 (3 main)")
+	assert.Contains(t, outputStr, "Status: Ongoing")
+	assert.Contains(t, outputStr, "  #1     The Way of Kings (2010) [Read]\n")
+	assert.Contains(t, outputStr, "→ #2.5   Edgedancer (2016) · novella [Want to Read]\n")
+	assert.Contains(t, outputStr, "  #3     Oathbringer (2017)\n")
+	assert.Contains(t, outputStr, "  1-2    The Stormlight Archive Box Set · compilation\n")
+	assert.Contains(t, outputStr, "  -      The Way of Kings Prime\n")
+	assert.Contains(t, outputStr, "Next up: Edgedancer (#2.5)")
+
+	assert.Equal(t, []interface{}{float64(1), float64(2), float64(3), float64(4), float64(5), float64(6)},
+		statusVariables["book_ids"])
+}
+
+func TestSeriesShowCmd_PrimaryOnlyJSON(t *testing.T) {
+	var statusVariables map[string]interface{}
+	url := seriesRequestServer(t, []interface{}{
+		map[string]interface{}{"id": 11, "book_id": 1, "status_id": 3},
+	}, &statusVariables)
+	cmd, output := newFlagTestCommand(t, url, "json", addSeriesShowFlags, "--primary-only")
+
+	require.NoError(t, seriesShowCmd.RunE(cmd, []string{"997"}))
+
+	var view seriesView
+	require.NoError(t, json.Unmarshal(output.Bytes(), &view))
+	require.Len(t, view.Books, 3)
+	assert.Equal(t, "Oathbringer", view.Books[2].Title)
+	assert.Equal(t, "Read", view.Books[0].Status)
+	assert.Empty(t, view.Books[1].Status)
+	require.NotNil(t, view.NextUnread)
+	assert.Equal(t, "Words of Radiance", view.NextUnread.Title)
+	require.NotNil(t, view.Completed)
+	assert.False(t, *view.Completed)
+}
+
+func TestSeriesShowCmd_StatusLookupFails(t *testing.T) {
+	var statusVariables map[string]interface{}
+	url := seriesRequestServer(t, nil, &statusVariables)
+	cmd, output := newBookTestCommand(url, "text")
+	var stderr strings.Builder
+	cmd.SetErr(&stderr)
+
+	require.NoError(t, seriesShowCmd.RunE(cmd, []string{"997"}))
+
+	assert.Contains(t, stderr.String(), "Warning: failed to get your reading statuses")
+	assert.Contains(t, output.String(), "  #1     The Way of Kings (2010)\n")
+	assert.NotContains(t, output.String(), "→")
+	assert.NotContains(t, output.String(), "Next up")
+}
+
+func TestSeriesShowCmd_NotFound(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(map[string]interface{}{"series": []interface{}{}}))
+	defer server.Close()
+
+	cmd, _ := newBookTestCommand(server.URL, "text")
+	err := seriesShowCmd.RunE(cmd, []string{"no-such-series"})
+	require.Error(t, err)
+	assert.Equal(t, `series "no-such-series" not found`, err.Error())
+}
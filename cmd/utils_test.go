@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	"hardcover-cli/internal/config"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMaskAPIKey(t *testing.T) {
@@ -32,6 +35,12 @@ func TestPrintHelpers(t *testing.T) {
 	assert.Equal(t, "Hello World!\n", buf.String())
 }
 
+func TestPluralize(t *testing.T) {
+	assert.Equal(t, "0 books", pluralize(0, "book", "books"))
+	assert.Equal(t, "1 book", pluralize(1, "book", "books"))
+	assert.Equal(t, "2 entries", pluralize(2, "entry", "entries"))
+}
+
 func TestGetConfig(t *testing.T) {
 	orig := globalConfig
 	defer func() { globalConfig = orig }()
@@ -57,6 +66,64 @@ func TestGetConfig(t *testing.T) {
 	assert.Nil(t, cfg)
 }
 
+func TestApplyRequestFlags(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.Flags().Int("max-retries", 0, "")
+	cmd.Flags().Duration("retry-delay", 0, "")
+	cmd.Flags().Duration("retry-max-delay", 0, "")
+	cmd.Flags().Int("rate-limit", 0, "")
+	require.NoError(t, cmd.Flags().Parse([]string{"--max-retries", "5", "--retry-max-delay", "1m", "--rate-limit", "30"}))
+
+	cfg := config.DefaultConfig()
+	require.NoError(t, applyRequestFlags(cmd, cfg))
+	assert.Equal(t, config.RetryConfig{MaxRetries: 5, BaseDelay: 500 * time.Millisecond, MaxDelay: time.Minute}, cfg.Retry)
+	assert.Equal(t, 30, cfg.RateLimit)
+
+	require.NoError(t, cmd.Flags().Parse([]string{"--rate-limit", "-1"}))
+	assert.EqualError(t, applyRequestFlags(cmd, cfg), "the rate limit cannot be negative")
+
+	require.NoError(t, cmd.Flags().Parse([]string{"--rate-limit", "0", "--max-retries", "-1"}))
+	assert.EqualError(t, applyRequestFlags(cmd, cfg), "retry settings cannot be negative")
+}
+
+func TestNewAuthenticatedClient_RequestFlags(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.APIKey = "test-api-key"
+	cmd := &cobra.Command{}
+	cmd.Flags().Int("max-retries", 0, "")
+	cmd.Flags().Int("rate-limit", 0, "")
+	cmd.SetContext(WithConfig(context.Background(), cfg))
+
+	require.NoError(t, cmd.Flags().Parse([]string{"--max-retries", "5"}))
+	_, err := newAuthenticatedClient(cmd)
+	require.NoError(t, err)
+	assert.Equal(t, 3, cfg.Retry.MaxRetries, "the flags must not change the loaded configuration")
+
+	require.NoError(t, cmd.Flags().Parse([]string{"--rate-limit", "-1"}))
+	_, err = newAuthenticatedClient(cmd)
+	assert.EqualError(t, err, "the rate limit cannot be negative")
+}
+
+func TestRootFlags_Verbose(t *testing.T) {
+	root := &cobra.Command{Use: "hardcover"}
+	addRootFlags(root)
+	var verbose bool
+	root.AddCommand(&cobra.Command{
+		Use: "child",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			var err error
+			verbose, err = cmd.Flags().GetBool("verbose")
+			return err
+		},
+	})
+	root.SetArgs([]string{"--verbose", "child"})
+
+	require.NoError(t, root.Execute())
+	assert.True(t, verbose)
+	// -v is left to main, which prints the version
+	assert.Nil(t, root.PersistentFlags().ShorthandLookup("v"))
+}
+
 func FuzzMaskAPIKey(f *testing.F) {
 	seeds := []string{"", "short", "1234567890abcdef"}
 	for _, s := range seeds {
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PrimaryAuthorRole is the role reported for contributions without an
+// explicit contribution, i.e. the book's primary authors.
+const PrimaryAuthorRole = "Author"
+
+// ContributionSortFields lists the book fields GetContributions can order by.
+var ContributionSortFields = []string{"release_year", "rating", "title", "users_count"}
+
+// GetAuthor fetches an author by Hardcover ID. It returns ErrNotFound when no
+// author has that ID.
+func (c *Client) GetAuthor(ctx context.Context, id int) (*AuthorDetail, error) {
+	return c.getAuthorWhere(ctx, map[string]interface{}{"id": eq(id)})
+}
+
+// GetAuthorBySlug fetches an author by URL slug. It returns ErrNotFound when
+// no author has that slug.
+func (c *Client) GetAuthorBySlug(ctx context.Context, slug string) (*AuthorDetail, error) {
+	return c.getAuthorWhere(ctx, map[string]interface{}{"slug": eq(slug)})
+}
+
+// getAuthorWhere executes the GetAuthor query with an authors_bool_exp filter.
+func (c *Client) getAuthorWhere(ctx context.Context, where map[string]interface{}) (*AuthorDetail, error) {
+	variables := map[string]interface{}{
+		"where": where,
+	}
+	var response GetAuthorResponse
+	if err := c.Execute(ctx, GetAuthorQuery, variables, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Authors) == 0 {
+		return nil, fmt.Errorf("no matching author: %w", ErrNotFound)
+	}
+	return &response.Authors[0], nil
+}
+
+// ContributionFilter selects the book contributions returned by
+// GetContributions. The author is identified by AuthorID or, when that is
+// zero, AuthorSlug. Roles are case-insensitive; "Author" selects primary
+// authorship and any other role is matched against the contribution.
+type ContributionFilter struct {
+	AuthorID   int
+	AuthorSlug string
+	Role       string
+	OrderBy    string
+	Descending bool
+}
+
+// where builds the contributions_bool_exp for the filter.
+func (f *ContributionFilter) where() (map[string]interface{}, error) {
+	conditions := []interface{}{
+		map[string]interface{}{"contributable_type": eq("Book")},
+	}
+	switch {
+	case f.AuthorID != 0:
+		conditions = append(conditions, map[string]interface{}{"author_id": eq(f.AuthorID)})
+	case f.AuthorSlug != "":
+		conditions = append(conditions, map[string]interface{}{"author": map[string]interface{}{"slug": eq(f.AuthorSlug)}})
+	default:
+		return nil, errors.New("an author ID or slug is required")
+	}
+
+	switch {
+	case f.Role == "":
+	case strings.EqualFold(f.Role, PrimaryAuthorRole):
+		conditions = append(conditions, map[string]interface{}{"contribution": map[string]interface{}{"_is_null": true}})
+	default:
+		conditions = append(conditions, map[string]interface{}{"contribution": iequal(f.Role)})
+	}
+
+	return map[string]interface{}{"_and": conditions}, nil
+}
+
+// orderBy builds the contributions_order_by list for the filter, ordering by
+// a field of the book.
+func (f *ContributionFilter) orderBy() ([]interface{}, error) {
+	field := f.OrderBy
+	if field == "" {
+		field = "release_year"
+	}
+	clause, err := orderByClause("books", field, ContributionSortFields, f.Descending)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{map[string]interface{}{"book": clause}}, nil
+}
+
+// GetContributions fetches every book contribution matching the filter,
+// requesting them a page at a time.
+func (c *Client) GetContributions(ctx context.Context, filter *ContributionFilter) ([]Contribution, error) {
+	where, err := filter.where()
+	if err != nil {
+		return nil, err
+	}
+	order, err := filter.orderBy()
+	if err != nil {
+		return nil, err
+	}
+
+	return collectPages(func(offset int) ([]Contribution, error) {
+		variables := map[string]interface{}{
+			"where":    where,
+			"order_by": order,
+			"limit":    pageSize,
+			"offset":   offset,
+		}
+		var response GetContributionsResponse
+		if err := c.Execute(ctx, GetContributionsQuery, variables, &response); err != nil {
+			return nil, err
+		}
+		return response.Contributions, nil
+	})
+}
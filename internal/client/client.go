@@ -16,6 +16,20 @@ type Client struct {
 	endpoint   string
 	apiKey     string
 	httpClient *http.Client
+	retry      RetryPolicy
+	limiter    *rateLimiter
+	verbose    io.Writer
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithVerbose has the client report to w whenever it waits, for the rate
+// limit or before a retry.
+func WithVerbose(w io.Writer) Option {
+	return func(c *Client) {
+		c.verbose = w
+	}
 }
 
 // GraphQLRequest represents a GraphQL request.
@@ -30,41 +44,75 @@ type GraphQLResponse struct {
 	Errors []GraphQLError  `json:"errors,omitempty"`
 }
 
-// GraphQLError represents a GraphQL error.
-type GraphQLError struct {
-	Message   string                 `json:"message"`
-	Locations []GraphQLErrorLocation `json:"locations,omitempty"`
-}
-
-// GraphQLErrorLocation represents the location of a GraphQL error.
-type GraphQLErrorLocation struct {
-	Line   int `json:"line"`
-	Column int `json:"column"`
-}
-
-// Error implements the error interface for GraphQLError.
-func (e GraphQLError) Error() string {
-	return e.Message
-}
-
-// NewClient creates a new GraphQL client.
-func NewClient(endpoint, apiKey string) *Client {
-	return &Client{
+// NewClient creates a new GraphQL client. Without options, requests are
+// limited to DefaultRateLimit a minute and failed requests are not retried.
+func NewClient(endpoint, apiKey string, options ...Option) *Client {
+	c := &Client{
 		endpoint: endpoint,
 		apiKey:   apiKey,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second, // 30 seconds is a reasonable timeout for HTTP requests
 		},
+		limiter: newRateLimiter(DefaultRateLimit),
 	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
 }
 
-// Execute performs a GraphQL query and unmarshals the result.
+// Execute performs a GraphQL query and unmarshals the result, retrying
+// failures as the client's retry policy allows.
 func (c *Client) Execute(
 	ctx context.Context,
 	query string,
 	variables map[string]interface{},
 	result interface{},
 ) error {
+	gqlResp, err := c.do(ctx, query, variables)
+	if err != nil {
+		return err
+	}
+
+	// Check for GraphQL errors
+	if len(gqlResp.Errors) > 0 {
+		return &GraphQLErrors{Errors: gqlResp.Errors}
+	}
+
+	return unmarshalData(gqlResp.Data, result)
+}
+
+// ExecutePartial performs a GraphQL query like Execute, but keeps whatever
+// data the API returns alongside errors: the data is unmarshalled into
+// result and the errors are returned as warnings. The error is only set
+// when the request failed or no data came back at all.
+func (c *Client) ExecutePartial(
+	ctx context.Context,
+	query string,
+	variables map[string]interface{},
+	result interface{},
+) (*GraphQLErrors, error) {
+	gqlResp, err := c.do(ctx, query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings *GraphQLErrors
+	if len(gqlResp.Errors) > 0 {
+		warnings = &GraphQLErrors{Errors: gqlResp.Errors}
+		if !hasData(gqlResp.Data) {
+			return nil, warnings
+		}
+	}
+
+	if err := unmarshalData(gqlResp.Data, result); err != nil {
+		return nil, err
+	}
+	return warnings, nil
+}
+
+// do sends a GraphQL request and parses the response.
+func (c *Client) do(ctx context.Context, query string, variables map[string]interface{}) (*GraphQLResponse, error) {
 	// Prepare the GraphQL request
 	gqlReq := GraphQLRequest{
 		Query:     query,
@@ -73,13 +121,69 @@ func (c *Client) Execute(
 
 	jsonData, err := json.Marshal(gqlReq)
 	if err != nil {
-		return fmt.Errorf("failed to marshal GraphQL request: %w", err)
+		return nil, fmt.Errorf("failed to marshal GraphQL request: %w", err)
+	}
+
+	body, err := c.post(ctx, jsonData, retryable(ctx, query))
+	if err != nil {
+		return nil, err
 	}
 
+	// Parse GraphQL response
+	var gqlResp GraphQLResponse
+	if unmarshalErr := json.Unmarshal(body, &gqlResp); unmarshalErr != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", unmarshalErr)
+	}
+	return &gqlResp, nil
+}
+
+// unmarshalData unmarshals the data of a response into result, if provided.
+func unmarshalData(data json.RawMessage, result interface{}) error {
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+	return nil
+}
+
+// hasData reports whether a response carries data, which is null when the
+// whole request failed.
+func hasData(data json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(data)
+	return len(trimmed) > 0 && !bytes.Equal(trimmed, []byte("null"))
+}
+
+// post sends a request body to the endpoint and returns the response body,
+// retrying failures when allowed and the retry policy permits.
+func (c *Client) post(ctx context.Context, payload []byte, allowRetry bool) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		body, hint, err := c.send(ctx, payload)
+		if err == nil {
+			return body, nil
+		}
+		if !allowRetry || hint == nil {
+			return nil, err
+		}
+		wait, ok := c.retry.delay(attempt, hint)
+		if !ok {
+			return nil, err
+		}
+		c.logf("Retrying in %s after: %v\n", wait.Round(time.Millisecond), err)
+		if sleepErr := sleep(ctx, wait); sleepErr != nil {
+			return nil, err
+		}
+	}
+}
+
+// send makes one attempt at a request. When it fails, the hint is non-nil
+// if the failure is worth retrying.
+func (c *Client) send(ctx context.Context, payload []byte) ([]byte, *retryHint, error) {
 	// Create HTTP request
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewBuffer(jsonData))
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(payload))
 	if err != nil {
-		return fmt.Errorf("failed to create HTTP request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
 
 	// Set headers
@@ -91,10 +195,15 @@ func (c *Client) Execute(
 		httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
 	}
 
+	// Wait for the rate limit
+	if err := c.waitForRateLimit(ctx); err != nil {
+		return nil, nil, err
+	}
+
 	// Make the HTTP request
 	httpResp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		return fmt.Errorf("failed to execute request: %w", err)
+		return nil, networkRetryHint(ctx), fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer func() {
 		if closeErr := httpResp.Body.Close(); closeErr != nil {
@@ -104,34 +213,43 @@ func (c *Client) Execute(
 		}
 	}()
 
+	if c.limiter != nil {
+		c.limiter.observe(httpResp)
+	}
+
 	// Read response body
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return nil, networkRetryHint(ctx), fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	// Check for HTTP errors
 	if httpResp.StatusCode != http.StatusOK {
-		return fmt.Errorf("HTTP error %d: %s", httpResp.StatusCode, string(body))
+		return nil, retryHintFor(httpResp), &HTTPError{
+			StatusCode: httpResp.StatusCode,
+			Body:       string(body),
+			RetryAfter: parseRetryAfter(httpResp.Header.Get("Retry-After"), time.Now()),
+		}
 	}
+	return body, nil, nil
+}
 
-	// Parse GraphQL response
-	var gqlResp GraphQLResponse
-	if unmarshalErr := json.Unmarshal(body, &gqlResp); unmarshalErr != nil {
-		return fmt.Errorf("failed to unmarshal response: %w", unmarshalErr)
+// waitForRateLimit waits until the rate limit allows another request.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.limiter == nil {
+		return nil
 	}
-
-	// Check for GraphQL errors
-	if len(gqlResp.Errors) > 0 {
-		return fmt.Errorf("GraphQL errors: %v", gqlResp.Errors)
+	wait := c.limiter.reserve()
+	if wait <= 0 {
+		return nil
 	}
+	c.logf("Waiting %s for the API rate limit\n", wait.Round(time.Millisecond))
+	return sleep(ctx, wait)
+}
 
-	// Unmarshal the data into the result if provided
-	if result != nil {
-		if dataErr := json.Unmarshal(gqlResp.Data, result); dataErr != nil {
-			return fmt.Errorf("failed to unmarshal data: %w", dataErr)
-		}
+// logf reports what the client is doing when it is verbose.
+func (c *Client) logf(format string, args ...interface{}) {
+	if c.verbose != nil {
+		_, _ = fmt.Fprintf(c.verbose, format, args...)
 	}
-
-	return nil
 }
@@ -1,10 +1,16 @@
 package client_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 	"unsafe"
@@ -13,6 +19,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"hardcover-cli/internal/client"
+	"hardcover-cli/internal/isbn"
 	"hardcover-cli/internal/testutil"
 )
 
@@ -22,6 +29,9 @@ type clientInternal struct {
 	endpoint   string
 	apiKey     string
 	httpClient *http.Client
+	retry      client.RetryPolicy
+	limiter    unsafe.Pointer
+	verbose    io.Writer
 }
 
 // errorRoundTripper simulates a network failure by always returning an error.
@@ -31,6 +41,16 @@ func (errorRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
 	return nil, errors.New("network error")
 }
 
+// countingRoundTripper simulates a network failure, counting the attempts.
+type countingRoundTripper struct {
+	calls int32
+}
+
+func (r *countingRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	atomic.AddInt32(&r.calls, 1)
+	return nil, errors.New("network error")
+}
+
 func TestNewClient(t *testing.T) {
 	endpoint := "https://api.hardcover.app/v1/graphql"
 	apiKey := "test-api-key"
@@ -110,6 +130,38 @@ func TestClient_Execute_GraphQLError(t *testing.T) {
 	assert.Contains(t, err.Error(), "Field 'test' doesn't exist")
 }
 
+func TestClient_Execute_GraphQLErrorDetails(t *testing.T) {
+	server := testutil.CreateTestServerWithHandler(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"errors": [
+			{"message": "field 'titel' not found in type: 'books'", "path": ["books", 0, "titel"],
+			 "extensions": {"code": "validation-failed", "path": "$.selectionSet.books.selectionSet.titel"}},
+			{"message": "Could not verify JWT: JWTExpired", "extensions": {"code": "invalid-jwt", "path": "$"}}
+		]}`))
+	})
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	err := c.Execute(context.Background(), "query { books { titel } }", nil, nil)
+
+	var gqlErrs *client.GraphQLErrors
+	require.ErrorAs(t, err, &gqlErrs)
+	require.Len(t, gqlErrs.Errors, 2)
+	assert.Equal(t, "books.0.titel", gqlErrs.Errors[0].FieldPath())
+	assert.Equal(t, "validation-failed", gqlErrs.Errors[0].Extensions.Code)
+	assert.Equal(t, "$.selectionSet.books.selectionSet.titel", gqlErrs.Errors[0].Extensions.Path)
+	assert.True(t, gqlErrs.HasCode("invalid-jwt"))
+	assert.Equal(t, "GraphQL errors: field 'titel' not found in type: 'books' (validation-failed); "+
+		"Could not verify JWT: JWTExpired (invalid-jwt)", err.Error())
+
+	wrapped := fmt.Errorf("failed to get books: %w", err)
+	require.ErrorIs(t, wrapped, client.ErrUnauthorized)
+	assert.NotErrorIs(t, wrapped, client.ErrNotFound)
+	var gqlErr client.GraphQLError
+	require.ErrorAs(t, wrapped, &gqlErr)
+	assert.Equal(t, "validation-failed", gqlErr.Extensions.Code)
+}
+
 func TestClient_Execute_HTTPError(t *testing.T) {
 	// Create test server that returns HTTP error
 	server := testutil.CreateTestServer(t, testutil.HTTPErrorResponse(http.StatusUnauthorized, "Unauthorized"))
@@ -123,6 +175,25 @@ func TestClient_Execute_HTTPError(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "HTTP error 401")
 	assert.Contains(t, err.Error(), "Unauthorized")
+
+	var httpErr *client.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusUnauthorized, httpErr.StatusCode)
+	assert.Contains(t, httpErr.Body, "Unauthorized")
+	require.ErrorIs(t, err, client.ErrUnauthorized)
+	assert.NotErrorIs(t, err, client.ErrRateLimited)
+}
+
+func TestClient_Execute_RateLimitedError(t *testing.T) {
+	_, url := flakyServer(t, http.StatusTooManyRequests, 1, http.Header{"Retry-After": {"30"}})
+	c := client.NewClient(url, "test-api-key", client.WithRateLimit(0))
+
+	err := c.Execute(context.Background(), "query { test }", nil, nil)
+
+	require.ErrorIs(t, err, client.ErrRateLimited)
+	var httpErr *client.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, 30*time.Second, httpErr.RetryAfter)
 }
 
 func TestClient_Execute_NetworkError(t *testing.T) {
@@ -244,6 +315,233 @@ func TestClient_Execute_NilResult(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// flakyServer answers every request with status until failures requests
+// have failed, then succeeds, sending header with every response. It counts
+// the requests it receives.
+func flakyServer(t *testing.T, status, failures int, header http.Header) (*int32, string) {
+	t.Helper()
+
+	var requests int32
+	server := testutil.CreateTestServerWithHandler(func(w http.ResponseWriter, _ *http.Request) {
+		for key, values := range header {
+			w.Header()[key] = values
+		}
+		if int(atomic.AddInt32(&requests, 1)) <= failures {
+			http.Error(w, "try again", status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"test": "success"}}`))
+	})
+	t.Cleanup(server.Close)
+	return &requests, server.URL
+}
+
+// fastRetries retries three times with millisecond delays.
+var fastRetries = client.RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+func TestClient_Execute_RetriesServerErrors(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			requests, url := flakyServer(t, status, 2, nil)
+			c := client.NewClient(url, "test-api-key", client.WithRetryPolicy(fastRetries))
+
+			var result map[string]interface{}
+			require.NoError(t, c.Execute(context.Background(), "query { test }", nil, &result))
+			assert.Equal(t, "success", result["test"])
+			assert.Equal(t, int32(3), atomic.LoadInt32(requests))
+		})
+	}
+}
+
+func TestClient_Execute_GivesUpAfterMaxRetries(t *testing.T) {
+	requests, url := flakyServer(t, http.StatusInternalServerError, 10, nil)
+	c := client.NewClient(url, "test-api-key", client.WithRetryPolicy(fastRetries))
+
+	err := c.Execute(context.Background(), "query { test }", nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "HTTP error 500")
+	assert.Equal(t, int32(4), atomic.LoadInt32(requests))
+}
+
+func TestClient_Execute_DoesNotRetryClientErrors(t *testing.T) {
+	requests, url := flakyServer(t, http.StatusBadRequest, 1, nil)
+	c := client.NewClient(url, "test-api-key", client.WithRetryPolicy(fastRetries))
+
+	require.Error(t, c.Execute(context.Background(), "query { test }", nil, nil))
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+}
+
+func TestClient_Execute_RetriesNetworkErrors(t *testing.T) {
+	c := client.NewClient("http://example.com", "test-api-key", client.WithRetryPolicy(fastRetries))
+	transport := &countingRoundTripper{}
+	ci := (*clientInternal)(unsafe.Pointer(c))
+	ci.httpClient = &http.Client{Transport: transport}
+
+	require.Error(t, c.Execute(context.Background(), "query { test }", nil, nil))
+	assert.Equal(t, int32(4), atomic.LoadInt32(&transport.calls))
+}
+
+func TestClient_Execute_RetriesOnlySafeMutations(t *testing.T) {
+	requests, url := flakyServer(t, http.StatusServiceUnavailable, 1, nil)
+	c := client.NewClient(url, "test-api-key", client.WithRetryPolicy(fastRetries))
+
+	require.Error(t, c.Execute(context.Background(), "mutation AddThing { test }", nil, nil))
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+
+	require.NoError(t, c.Execute(client.WithRetrySafe(context.Background()), "mutation SetThing { test }", nil, nil))
+	assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+}
+
+func TestClient_Execute_HonorsRetryAfter(t *testing.T) {
+	// A wait longer than the policy allows is not retried
+	requests, url := flakyServer(t, http.StatusTooManyRequests, 1, http.Header{"Retry-After": {"120"}})
+	c := client.NewClient(url, "test-api-key", client.WithRetryPolicy(fastRetries))
+	require.Error(t, c.Execute(context.Background(), "query { test }", nil, nil))
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+
+	// A date in the past means retry at once
+	requests, url = flakyServer(t, http.StatusTooManyRequests, 1,
+		http.Header{"Retry-After": {time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)}})
+	c = client.NewClient(url, "test-api-key", client.WithRetryPolicy(fastRetries))
+	require.NoError(t, c.Execute(context.Background(), "query { test }", nil, nil))
+	assert.Equal(t, int32(2), atomic.LoadInt32(requests))
+}
+
+func TestClient_Execute_StopsRetryingWhenCancelled(t *testing.T) {
+	requests, url := flakyServer(t, http.StatusServiceUnavailable, 10, nil)
+	c := client.NewClient(url, "test-api-key", client.WithRetryPolicy(client.RetryPolicy{MaxRetries: 3, BaseDelay: time.Hour}))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := c.Execute(ctx, "query { test }", nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "HTTP error 503")
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+}
+
+// limitedServer succeeds, sending header with every response, and counts
+// the requests it receives.
+func limitedServer(t *testing.T, header http.Header) (*int32, string) {
+	t.Helper()
+	return flakyServer(t, http.StatusOK, 0, header)
+}
+
+func TestClient_Execute_WaitsForRateLimit(t *testing.T) {
+	requests, url := limitedServer(t, nil)
+	var verbose bytes.Buffer
+	// Six a minute allows one request at once, then one every 12 seconds
+	c := client.NewClient(url, "test-api-key", client.WithRateLimit(6), client.WithVerbose(&verbose))
+
+	require.NoError(t, c.Execute(context.Background(), "query { test }", nil, nil))
+	assert.Empty(t, verbose.String())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := c.Execute(ctx, "query { test }", nil, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Regexp(t, `^Waiting 1[12](\.\d+)?s for the API rate limit\n$`, verbose.String())
+	assert.Equal(t, int32(1), atomic.LoadInt32(requests))
+}
+
+func TestClient_Execute_AdaptsToRateLimitHeaders(t *testing.T) {
+	_, url := limitedServer(t, http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"40"}})
+	var verbose bytes.Buffer
+	c := client.NewClient(url, "test-api-key", client.WithVerbose(&verbose))
+
+	require.NoError(t, c.Execute(context.Background(), "query { test }", nil, nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	require.Error(t, c.Execute(ctx, "query { test }", nil, nil))
+	assert.Regexp(t, `^Waiting (39\.\d+|40)s for the API rate limit\n$`, verbose.String())
+}
+
+func TestClient_Execute_RateLimitSharedAcrossGoroutines(t *testing.T) {
+	requests, url := limitedServer(t, nil)
+	// 600 a minute allows 100 requests at once
+	c := client.NewClient(url, "test-api-key", client.WithRateLimit(600))
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, c.Execute(context.Background(), "query { test }", nil, nil))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(20), atomic.LoadInt32(requests))
+}
+
+func TestClient_Execute_ReportsRetries(t *testing.T) {
+	_, url := flakyServer(t, http.StatusServiceUnavailable, 1, nil)
+	var verbose bytes.Buffer
+	c := client.NewClient(url, "test-api-key", client.WithRetryPolicy(fastRetries), client.WithVerbose(&verbose))
+
+	require.NoError(t, c.Execute(context.Background(), "query { test }", nil, nil))
+	assert.Regexp(t, `^Retrying in \d+(\.\d+)?ms after: HTTP error 503: try again\n\n$`, verbose.String())
+}
+
+// partialServer answers every request with the given response body.
+func partialServer(t *testing.T, body string) string {
+	t.Helper()
+	server := testutil.CreateTestServerWithHandler(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	})
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestClient_ExecutePartial(t *testing.T) {
+	url := partialServer(t, `{
+		"data": {"books": [{"id": 1, "title": "Dune", "cover": null}]},
+		"errors": [{"message": "image service unavailable", "path": ["books", 0, "cover"]}]
+	}`)
+	c := client.NewClient(url, "test-api-key")
+
+	var result struct {
+		Books []struct {
+			ID    int    `json:"id"`
+			Title string `json:"title"`
+		} `json:"books"`
+	}
+	warnings, err := c.ExecutePartial(context.Background(), "query { books { id title cover } }", nil, &result)
+	require.NoError(t, err)
+	require.Len(t, result.Books, 1)
+	assert.Equal(t, "Dune", result.Books[0].Title)
+	require.NotNil(t, warnings)
+	require.Len(t, warnings.Errors, 1)
+	assert.Equal(t, "books.0.cover", warnings.Errors[0].FieldPath())
+
+	// Execute still treats the errors as a failure
+	err = c.Execute(context.Background(), "query { books { id title cover } }", nil, &result)
+	var gqlErrs *client.GraphQLErrors
+	require.ErrorAs(t, err, &gqlErrs)
+}
+
+func TestClient_ExecutePartial_NoData(t *testing.T) {
+	url := partialServer(t, `{"data": null, "errors": [{"message": "Could not verify JWT", "extensions": {"code": "invalid-jwt"}}]}`)
+	c := client.NewClient(url, "test-api-key")
+
+	var result map[string]interface{}
+	warnings, err := c.ExecutePartial(context.Background(), "query { test }", nil, &result)
+	assert.Nil(t, warnings)
+	require.ErrorIs(t, err, client.ErrUnauthorized)
+}
+
+func TestClient_ExecutePartial_Success(t *testing.T) {
+	url := partialServer(t, `{"data": {"test": "success"}}`)
+	c := client.NewClient(url, "test-api-key")
+
+	var result map[string]interface{}
+	warnings, err := c.ExecutePartial(context.Background(), "query { test }", nil, &result)
+	require.NoError(t, err)
+	assert.Nil(t, warnings)
+	assert.Equal(t, "success", result["test"])
+}
+
 func TestGraphQLError_Error(t *testing.T) {
 	err := client.GraphQLError{
 		Message: "Test error message",
@@ -254,3 +552,543 @@ func TestGraphQLError_Error(t *testing.T) {
 
 	assert.Equal(t, "Test error message", err.Error())
 }
+
+func TestSearch_TypedResults(t *testing.T) {
+	server := testutil.CreateTestServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req client.GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+			return
+		}
+		assert.Contains(t, req.Query, "query Search")
+		assert.Equal(t, "dune", req.Variables["query"])
+		assert.Equal(t, "Author", req.Variables["query_type"])
+		assert.InDelta(t, 1, req.Variables["page"], 0)
+		assert.InDelta(t, client.DefaultSearchPerPage, req.Variables["per_page"], 0)
+
+		response := client.GraphQLResponse{
+			Data: json.RawMessage(`{"search": {
+				"ids": ["204214"],
+				"page": 1,
+				"per_page": 25,
+				"query": "dune",
+				"query_type": "Author",
+				"results": {
+					"found": 1,
+					"out_of": 500,
+					"page": 1,
+					"hits": [{
+						"document": {"id": "204214", "name": "Frank Herbert", "books_count": 42,
+							"alternate_names": ["Franklin Patrick Herbert"]},
+						"highlights": [{"field": "name", "snippet": "<mark>Frank</mark> Herbert",
+							"matched_tokens": ["Frank"]}],
+						"text_match": 578730123365187705
+					}]
+				}
+			}}`),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	})
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	results, err := client.Search[client.AuthorDocument](context.Background(), c, "dune", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, results.Found)
+	assert.Equal(t, 500, results.OutOf)
+	require.Len(t, results.Hits, 1)
+	assert.Equal(t, "Frank Herbert", results.Hits[0].Document.Name)
+	assert.Equal(t, []string{"Franklin Patrick Herbert"}, results.Hits[0].Document.AlternateNames)
+	assert.Equal(t, "name", results.Hits[0].Highlights[0].Field)
+	assert.Equal(t, []client.AuthorDocument{results.Hits[0].Document}, results.Documents())
+}
+
+func TestSearch_Error(t *testing.T) {
+	searchData := map[string]interface{}{
+		"search": map[string]interface{}{
+			"error": "invalid query_type",
+		},
+	}
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(searchData))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	_, err := c.SearchBooks(context.Background(), "dune", &client.SearchOptions{Page: 2, PerPage: 10})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid query_type")
+}
+
+func TestSearchPages_WalksUntilFound(t *testing.T) {
+	var pages []float64
+	server := testutil.CreateTestServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req client.GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+			return
+		}
+		page, ok := req.Variables["page"].(float64)
+		require.True(t, ok)
+		pages = append(pages, page)
+
+		hits := `[{"document": {"id": "1", "title": "One"}}, {"document": {"id": "2", "title": "Two"}}]`
+		if page == 2 {
+			hits = `[{"document": {"id": "3", "title": "Three"}}]`
+		}
+		response := client.GraphQLResponse{
+			Data: json.RawMessage(`{"search": {"results": {"found": 3, "hits": ` + hits + `}}}`),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	})
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+
+	var titles []string
+	for results, err := range client.SearchPages[client.BookDocument](
+		context.Background(), c, "numbers", &client.SearchOptions{PerPage: 2},
+	) {
+		require.NoError(t, err)
+		for _, book := range results.Documents() {
+			titles = append(titles, book.Title)
+		}
+	}
+
+	assert.Equal(t, []string{"One", "Two", "Three"}, titles)
+	assert.Equal(t, []float64{1, 2}, pages)
+}
+
+func TestSearchPages_StopsOnError(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.HTTPErrorResponse(http.StatusInternalServerError, "boom"))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+
+	calls := 0
+	for results, err := range client.SearchPages[client.UserDocument](context.Background(), c, "x", nil) {
+		calls++
+		assert.Nil(t, results)
+		require.Error(t, err)
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestSearch_SendsSortFieldsAndWeights(t *testing.T) {
+	var variables map[string]interface{}
+	server := testutil.CreateTestServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req client.GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+			return
+		}
+		variables = req.Variables
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(client.GraphQLResponse{Data: json.RawMessage(`{"search": {}}`)}); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	})
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	_, err := c.SearchBooks(context.Background(), "dune", &client.SearchOptions{
+		Sort:    "users_count:desc, rating:desc",
+		Fields:  "title,author_names",
+		Weights: "5, 1",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "users_count:desc,rating:desc", variables["sort"])
+	assert.Equal(t, "title,author_names", variables["fields"])
+	assert.Equal(t, "5,1", variables["weights"])
+}
+
+func TestSearchOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		queryType client.SearchType
+		opts      client.SearchOptions
+		wantErr   []string
+	}{
+		{
+			name:      "defaults",
+			queryType: client.SearchTypeBook,
+		},
+		{
+			name:      "valid sort with relevance",
+			queryType: client.SearchTypeBook,
+			opts:      client.SearchOptions{Sort: "_text_match:desc,users_count:desc"},
+		},
+		{
+			name:      "weights match default fields",
+			queryType: client.SearchTypeSeries,
+			opts:      client.SearchOptions{Weights: "2,1,1"},
+		},
+		{
+			name:      "typo in sort field",
+			queryType: client.SearchTypeBook,
+			opts:      client.SearchOptions{Sort: "user_count:desc"},
+			wantErr:   []string{`unknown sort field "user_count" for book search`, `did you mean "users_count"?`},
+		},
+		{
+			name:      "field from another type",
+			queryType: client.SearchTypePublisher,
+			opts:      client.SearchOptions{Fields: "title"},
+			wantErr:   []string{`unknown field "title" for publisher search`, "available: editions_count, name"},
+		},
+		{
+			name:      "invalid sort direction",
+			queryType: client.SearchTypeAuthor,
+			opts:      client.SearchOptions{Sort: "books_count:down"},
+			wantErr:   []string{`invalid sort direction "down"`},
+		},
+		{
+			name:      "weights do not match fields",
+			queryType: client.SearchTypeBook,
+			opts:      client.SearchOptions{Fields: "title,author_names", Weights: "5"},
+			wantErr:   []string{"got 1 weights for 2 fields (title,author_names)"},
+		},
+		{
+			name:      "non-numeric weight",
+			queryType: client.SearchTypeUser,
+			opts:      client.SearchOptions{Weights: "2,high,1"},
+			wantErr:   []string{`invalid weight "high"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate(tt.queryType)
+			if len(tt.wantErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, want := range tt.wantErr {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
+func TestGetEditionsByISBN_Batches(t *testing.T) {
+	var numbers []isbn.ISBN
+	for body := 978000000000; len(numbers) < 150; body++ {
+		for check := 0; check < 10; check++ {
+			if number, err := isbn.Parse(fmt.Sprintf("%d%d", body, check)); err == nil {
+				numbers = append(numbers, number)
+				break
+			}
+		}
+	}
+
+	var batchSizes []int
+	server := testutil.CreateTestServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req client.GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+			return
+		}
+		isbn13s, ok := req.Variables["isbn_13"].([]interface{})
+		require.True(t, ok)
+		batchSizes = append(batchSizes, len(isbn13s))
+
+		data := fmt.Sprintf(`{"editions": [{"id": %d, "isbn_13": %q}]}`, len(batchSizes), isbn13s[0])
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(client.GraphQLResponse{Data: json.RawMessage(data)}); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	})
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	editions, err := c.GetEditionsByISBN(context.Background(), numbers)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{100, 50}, batchSizes)
+	require.Len(t, editions, 2)
+	assert.True(t, editions[0].Matches(numbers[0]))
+	assert.True(t, editions[1].Matches(numbers[100]))
+	assert.False(t, editions[1].Matches(numbers[0]))
+}
+
+func TestGetEditions_PagesUntilShortPage(t *testing.T) {
+	var offsets []float64
+	server := testutil.CreateTestServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		var req client.GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+			return
+		}
+		offset, ok := req.Variables["offset"].(float64)
+		require.True(t, ok)
+		offsets = append(offsets, offset)
+
+		count := 100
+		if offset > 0 {
+			count = 1
+		}
+		editions := make([]string, count)
+		for i := range editions {
+			editions[i] = fmt.Sprintf(`{"id": %d}`, int(offset)+i)
+		}
+		data := `{"editions": [` + strings.Join(editions, ",") + `]}`
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(client.GraphQLResponse{Data: json.RawMessage(data)}); err != nil {
+			t.Errorf("Failed to encode response: %v", err)
+		}
+	})
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	editions, err := c.GetEditions(context.Background(), &client.EditionFilter{BookID: 1})
+	require.NoError(t, err)
+
+	assert.Len(t, editions, 101)
+	assert.Equal(t, []float64{0, 100}, offsets)
+}
+
+func TestGetEditions_RequiresBook(t *testing.T) {
+	c := client.NewClient("http://unused.invalid", "test-api-key")
+	_, err := c.GetEditions(context.Background(), &client.EditionFilter{Format: "hardcover"})
+	require.EqualError(t, err, "a book ID or slug is required")
+}
+
+func TestSeriesBookEntry_IsPrimary(t *testing.T) {
+	position := func(p float64) *float64 { return &p }
+	tests := []struct {
+		name  string
+		entry client.SeriesBookEntry
+		want  bool
+	}{
+		{name: "whole position", entry: client.SeriesBookEntry{Position: position(2), Book: &client.SeriesBook{}}, want: true},
+		{name: "novella", entry: client.SeriesBookEntry{Position: position(2.5), Book: &client.SeriesBook{}}},
+		{name: "unnumbered", entry: client.SeriesBookEntry{Book: &client.SeriesBook{}}},
+		{name: "compilation", entry: client.SeriesBookEntry{Position: position(1), Book: &client.SeriesBook{Compilation: true}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.entry.IsPrimary())
+		})
+	}
+}
+
+func TestUserBookStatus_String(t *testing.T) {
+	assert.Equal(t, "Want to Read", client.StatusWantToRead.String())
+	assert.Equal(t, "Did Not Finish", client.StatusDidNotFinish.String())
+	assert.Equal(t, "Status 42", client.UserBookStatus(42).String())
+}
+
+func TestParseUserBookStatus(t *testing.T) {
+	tests := []struct {
+		input string
+		want  client.UserBookStatus
+	}{
+		{input: "want-to-read", want: client.StatusWantToRead},
+		{input: "Want to Read", want: client.StatusWantToRead},
+		{input: "reading", want: client.StatusCurrentlyReading},
+		{input: "currently_reading", want: client.StatusCurrentlyReading},
+		{input: "READ", want: client.StatusRead},
+		{input: "DNF", want: client.StatusDidNotFinish},
+		{input: "4", want: client.StatusPaused},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := client.ParseUserBookStatus(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := client.ParseUserBookStatus("7")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown status "7"`)
+}
+
+func TestAddUserBook_ReportsAPIError(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(map[string]interface{}{
+		"insert_user_book": map[string]interface{}{"error": "Book not found"},
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	_, err := c.AddUserBook(context.Background(), &client.UserBookInput{BookID: 1, StatusID: client.StatusRead})
+	require.Error(t, err)
+	assert.Equal(t, "Book not found", err.Error())
+}
+
+func TestGetUserBooks_RequiresUser(t *testing.T) {
+	c := client.NewClient("http://unused.invalid", "test-api-key")
+	_, err := c.GetUserBooks(context.Background(), &client.UserBookFilter{})
+	require.EqualError(t, err, "a user ID is required")
+}
+
+func TestGetUserBooks_FailsAtFirstPageWithErrors(t *testing.T) {
+	userBooks := make([]map[string]interface{}, 100)
+	for i := range userBooks {
+		userBooks[i] = map[string]interface{}{"id": i + 1, "book_id": i + 1}
+	}
+	var requests atomic.Int32
+	server := testutil.CreateTestServerWithHandler(func(w http.ResponseWriter, _ *http.Request) {
+		page := userBooks
+		if requests.Add(1) > 1 {
+			page = nil
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data":   map[string]interface{}{"user_books": page},
+			"errors": []interface{}{map[string]interface{}{"message": "edition service unavailable"}},
+		})
+	})
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	_, err := c.GetUserBooks(context.Background(), &client.UserBookFilter{UserID: 42})
+	var gqlErrs *client.GraphQLErrors
+	require.ErrorAs(t, err, &gqlErrs)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestGetMyUserBooks(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(map[string]interface{}{
+		"me": []interface{}{map[string]interface{}{"user_books": []interface{}{
+			map[string]interface{}{"id": 7, "book_id": 328491, "status_id": 3},
+		}}},
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	userBooks, err := c.GetMyUserBooks(context.Background(), []int{328491, 1})
+	require.NoError(t, err)
+	require.Len(t, userBooks, 1)
+	assert.Equal(t, 7, userBooks[328491].ID)
+}
+
+func TestGetMyUserBooks_NoUser(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(map[string]interface{}{"me": []interface{}{}}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	_, err := c.GetMyUserBooks(context.Background(), []int{328491})
+	require.ErrorIs(t, err, client.ErrUnauthorized)
+}
+
+func TestCurrentUserID(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(map[string]interface{}{
+		"me": []interface{}{map[string]interface{}{"id": 42}},
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	userID, err := c.CurrentUserID(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 42, userID)
+}
+
+func TestCurrentUserID_NoUser(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(map[string]interface{}{"me": []interface{}{}}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	_, err := c.CurrentUserID(context.Background())
+	require.ErrorIs(t, err, client.ErrUnauthorized)
+}
+
+func TestUserBookInput_MarshalClearRating(t *testing.T) {
+	data, err := json.Marshal(&client.UserBookInput{StatusID: client.StatusRead, ClearRating: true})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status_id": 3, "rating": null}`, string(data))
+
+	data, err = json.Marshal(&client.UserBookInput{StatusID: client.StatusRead})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status_id": 3}`, string(data))
+}
+
+func TestUserBook_ActiveReadAndLength(t *testing.T) {
+	userBook := &client.UserBook{
+		Book:    &client.BookSummary{Pages: 617},
+		Edition: &client.UserBookEdition{AudioSeconds: 75600},
+		Reads: []client.UserBookRead{
+			{ID: 1, FinishedAt: "2024-01-20"},
+			{ID: 2, PausedAt: "2024-03-10", Edition: &client.UserBookEdition{Pages: 412}},
+			{ID: 3, FinishedAt: "2024-05-01"},
+		},
+	}
+
+	read := userBook.ActiveRead()
+	require.NotNil(t, read)
+	assert.Equal(t, 2, read.ID)
+
+	pages, seconds := userBook.Length(read)
+	assert.Equal(t, 412, pages)
+	assert.Equal(t, 75600, seconds)
+
+	pages, seconds = userBook.Length(nil)
+	assert.Zero(t, pages, "an audiobook edition is not measured in the book's pages")
+	assert.Equal(t, 75600, seconds)
+
+	userBook.Reads = userBook.Reads[:1]
+	assert.Nil(t, userBook.ActiveRead())
+}
+
+func TestParsePrivacySetting(t *testing.T) {
+	for _, value := range []string{"private", "Private", "3"} {
+		privacy, err := client.ParsePrivacySetting(value)
+		require.NoError(t, err)
+		assert.Equal(t, client.PrivacyPrivate, privacy)
+	}
+	assert.Equal(t, "followers", client.PrivacyFollowers.String())
+
+	_, err := client.ParsePrivacySetting("friends")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "available: public, followers, private")
+}
+
+func TestReadingJournal_PageAndSetPage(t *testing.T) {
+	journal := client.ReadingJournal{Metadata: map[string]interface{}{"page": float64(142), "chapter": "3"}}
+	assert.Equal(t, 142, journal.Page())
+	assert.Equal(t, 0, (&client.ReadingJournal{}).Page())
+
+	input := &client.ReadingJournalInput{}
+	require.NoError(t, input.SetPage(journal.Metadata, 0))
+	assert.JSONEq(t, `{"chapter": "3"}`, string(input.Metadata))
+	assert.InDelta(t, 142, journal.Metadata["page"], 0, "SetPage must not change the metadata it is given")
+	assert.False(t, input.IsEmpty())
+	assert.True(t, (&client.ReadingJournalInput{}).IsEmpty())
+}
+
+func TestAddGoal_ReportsAPIErrors(t *testing.T) {
+	server := testutil.CreateTestServer(t, testutil.SuccessResponse(map[string]interface{}{
+		"insert_goal": map[string]interface{}{"errors": []string{"Goal must be positive", "End date is invalid"}},
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test-api-key")
+	_, err := c.AddGoal(context.Background(), &client.GoalInput{})
+	require.EqualError(t, err, "a metric, a positive goal and start and end dates are required")
+
+	_, err = c.AddGoal(context.Background(), &client.GoalInput{
+		Metric: client.GoalMetricBooks, Goal: 52, StartDate: "2026-01-01", EndDate: "2026-12-31",
+	})
+	require.EqualError(t, err, "Goal must be positive; End date is invalid")
+}
+
+func TestList_URLAndListBookFor(t *testing.T) {
+	list := client.List{Slug: "favourite-sf", ListBooks: []client.ListBook{{ID: 1, BookID: 10}, {ID: 2, BookID: 20}}}
+	assert.Empty(t, list.URL())
+	require.NotNil(t, list.ListBookFor(20))
+	assert.Equal(t, 2, list.ListBookFor(20).ID)
+	assert.Nil(t, list.ListBookFor(30))
+
+	require.NoError(t, json.Unmarshal([]byte(`{"user": {"username": "alice"}}`), &list))
+	assert.Equal(t, "https://hardcover.app/@alice/lists/favourite-sf", list.URL())
+}
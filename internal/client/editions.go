@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// EditionSortFields lists the editions fields GetEditions can order by.
+var EditionSortFields = []string{"release_date", "pages", "users_count"}
+
+// Format describes the edition's format, preferring the most specific value
+// available.
+func (e *EditionDetail) Format() string {
+	return editionFormat(e.EditionFormat, e.PhysicalFormat, e.ReadingFormat)
+}
+
+// Format describes the edition's format, preferring the most specific value
+// available.
+func (e *UserBookEdition) Format() string {
+	return editionFormat(e.EditionFormat, e.PhysicalFormat, e.ReadingFormat)
+}
+
+// editionFormat picks the most specific of an edition's format fields.
+func editionFormat(edition, physical string, reading *ReadingFormatName) string {
+	switch {
+	case edition != "":
+		return edition
+	case physical != "":
+		return physical
+	case reading != nil:
+		return reading.Format
+	default:
+		return ""
+	}
+}
+
+// EditionFilter selects the editions returned by GetEditions. The book is
+// identified by BookID or, when that is zero, BookSlug. Format, Language
+// and Publisher are case-insensitive; Format and Publisher match substrings.
+type EditionFilter struct {
+	BookID     int
+	BookSlug   string
+	Format     string
+	Language   string
+	Publisher  string
+	OrderBy    string
+	Descending bool
+}
+
+// where builds the editions_bool_exp for the filter.
+func (f *EditionFilter) where() (map[string]interface{}, error) {
+	var conditions []interface{}
+	switch {
+	case f.BookID != 0:
+		conditions = append(conditions, map[string]interface{}{"book_id": eq(f.BookID)})
+	case f.BookSlug != "":
+		conditions = append(conditions, map[string]interface{}{"book": map[string]interface{}{"slug": eq(f.BookSlug)}})
+	default:
+		return nil, errors.New("a book ID or slug is required")
+	}
+
+	if f.Format != "" {
+		pattern := ilike(f.Format)
+		conditions = append(conditions, map[string]interface{}{"_or": []interface{}{
+			map[string]interface{}{"edition_format": pattern},
+			map[string]interface{}{"physical_format": pattern},
+			map[string]interface{}{"reading_format": map[string]interface{}{"format": pattern}},
+		}})
+	}
+	if f.Language != "" {
+		code := strings.ToLower(f.Language)
+		conditions = append(conditions, map[string]interface{}{"language": map[string]interface{}{"_or": []interface{}{
+			map[string]interface{}{"language": iequal(f.Language)},
+			map[string]interface{}{"code2": eq(code)},
+			map[string]interface{}{"code3": eq(code)},
+		}}})
+	}
+	if f.Publisher != "" {
+		conditions = append(conditions, map[string]interface{}{"publisher": map[string]interface{}{"name": ilike(f.Publisher)}})
+	}
+
+	return map[string]interface{}{"_and": conditions}, nil
+}
+
+// orderBy builds the editions_order_by list for the filter. Editions without
+// a value for the sort field are listed last, and ties are broken by the
+// number of readers.
+func (f *EditionFilter) orderBy() ([]interface{}, error) {
+	field := f.OrderBy
+	if field == "" {
+		field = "users_count"
+	}
+	clause, err := orderByClause("editions", field, EditionSortFields, f.Descending)
+	if err != nil {
+		return nil, err
+	}
+
+	order := []interface{}{clause}
+	if field != "users_count" {
+		order = append(order, map[string]interface{}{"users_count": "desc"})
+	}
+	return order, nil
+}
+
+// GetEditions fetches every edition matching the filter, requesting them a
+// page at a time.
+func (c *Client) GetEditions(ctx context.Context, filter *EditionFilter) ([]EditionDetail, error) {
+	where, err := filter.where()
+	if err != nil {
+		return nil, err
+	}
+	order, err := filter.orderBy()
+	if err != nil {
+		return nil, err
+	}
+
+	return collectPages(func(offset int) ([]EditionDetail, error) {
+		variables := map[string]interface{}{
+			"where":    where,
+			"order_by": order,
+			"limit":    pageSize,
+			"offset":   offset,
+		}
+		var response GetEditionsResponse
+		if err := c.Execute(ctx, GetEditionsQuery, variables, &response); err != nil {
+			return nil, err
+		}
+		return response.Editions, nil
+	})
+}
+
+// GetEdition fetches an edition and its parent book by Hardcover ID. It
+// returns ErrNotFound when no edition has that ID.
+func (c *Client) GetEdition(ctx context.Context, id int) (*EditionDetail, error) {
+	variables := map[string]interface{}{
+		"where": map[string]interface{}{"id": eq(id)},
+	}
+	var response GetEditionsResponse
+	if err := c.Execute(ctx, GetEditionQuery, variables, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Editions) == 0 {
+		return nil, fmt.Errorf("no edition with ID %d: %w", id, ErrNotFound)
+	}
+	return &response.Editions[0], nil
+}
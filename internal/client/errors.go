@@ -0,0 +1,139 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Errors that API failures can be classified as with errors.Is.
+var (
+	// ErrNotFound is returned by lookup helpers when no record matches.
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized means the API key is missing, invalid or expired.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrRateLimited means the API refused a request for exceeding the
+	// rate limit.
+	ErrRateLimited = errors.New("rate limited")
+)
+
+// graphQLErrorKinds classifies the Hasura error codes that have a sentinel
+// error.
+var graphQLErrorKinds = map[string]error{
+	"invalid-jwt":             ErrUnauthorized,
+	"jwt-invalid-claims":      ErrUnauthorized,
+	"jwt-missing-role-claims": ErrUnauthorized,
+	"invalid-headers":         ErrUnauthorized,
+	"not-found":               ErrNotFound,
+}
+
+// GraphQLError represents a GraphQL error.
+type GraphQLError struct {
+	Message   string                 `json:"message"`
+	Locations []GraphQLErrorLocation `json:"locations,omitempty"`
+	// Path leads to the field that failed, as field names and list
+	// indexes.
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions GraphQLErrorExtensions `json:"extensions,omitempty"`
+}
+
+// GraphQLErrorLocation represents the location of a GraphQL error.
+type GraphQLErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// GraphQLErrorExtensions holds the details Hasura adds to an error: a code
+// such as "validation-failed", "access-denied" or "invalid-jwt", and the
+// JSON path of the part of the request at fault.
+type GraphQLErrorExtensions struct {
+	Code string `json:"code,omitempty"`
+	Path string `json:"path,omitempty"`
+}
+
+// Error implements the error interface for GraphQLError.
+func (e GraphQLError) Error() string {
+	return e.Message
+}
+
+// Is reports whether the error's code classifies it as target.
+func (e GraphQLError) Is(target error) bool {
+	kind, ok := graphQLErrorKinds[e.Extensions.Code]
+	return ok && kind == target
+}
+
+// FieldPath returns the path of the field that failed in dotted form, such
+// as "books.0.title", or "" when the error has no path.
+func (e GraphQLError) FieldPath() string {
+	parts := make([]string, len(e.Path))
+	for i, part := range e.Path {
+		parts[i] = fmt.Sprint(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// GraphQLErrors is returned by Execute when the API reports errors. It
+// matches a sentinel error with errors.Is when any of its errors does.
+type GraphQLErrors struct {
+	Errors []GraphQLError
+}
+
+// Error implements the error interface for GraphQLErrors.
+func (e *GraphQLErrors) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Message
+		if code := err.Extensions.Code; code != "" {
+			messages[i] += " (" + code + ")"
+		}
+	}
+	return "GraphQL errors: " + strings.Join(messages, "; ")
+}
+
+// Unwrap returns the individual errors.
+func (e *GraphQLErrors) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// HasCode reports whether any of the errors has the given code.
+func (e *GraphQLErrors) HasCode(code string) bool {
+	for _, err := range e.Errors {
+		if err.Extensions.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// HTTPError is returned by Execute when the API answers with a status
+// other than 200 OK.
+type HTTPError struct {
+	StatusCode int
+	Body       string
+	// RetryAfter is the wait the server asked for, or zero.
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface for HTTPError.
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("HTTP error %d: %s", e.StatusCode, e.Body)
+}
+
+// Is classifies 401 and 403 responses as ErrUnauthorized and 429 responses
+// as ErrRateLimited.
+func (e *HTTPError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	default:
+		return false
+	}
+}
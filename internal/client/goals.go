@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Goal metrics: what a reading goal counts.
+const (
+	GoalMetricBooks = "book"
+	GoalMetricPages = "pages"
+)
+
+// GoalInput holds the fields of a reading goal to set. Zero and nil fields
+// are left unchanged.
+type GoalInput struct {
+	Metric           string          `json:"metric,omitempty"`
+	Goal             int             `json:"goal,omitempty"`
+	Description      *string         `json:"description,omitempty"`
+	StartDate        string          `json:"start_date,omitempty"`
+	EndDate          string          `json:"end_date,omitempty"`
+	PrivacySettingID PrivacySetting  `json:"privacy_setting_id,omitempty"`
+	Archived         *bool           `json:"archived,omitempty"`
+	Conditions       json.RawMessage `json:"conditions,omitempty"`
+}
+
+// GetGoals fetches a user's reading goals, latest ending first. Archived
+// goals are only included when includeArchived is set.
+func (c *Client) GetGoals(ctx context.Context, userID int, includeArchived bool) ([]Goal, error) {
+	if userID == 0 {
+		return nil, errors.New("a user ID is required")
+	}
+	where := map[string]interface{}{
+		"user_id": eq(userID),
+	}
+	if !includeArchived {
+		where["archived"] = eq(false)
+	}
+	return c.getGoals(ctx, where)
+}
+
+// GetGoal fetches one of a user's reading goals. It returns ErrNotFound when
+// the user has no goal with that ID.
+func (c *Client) GetGoal(ctx context.Context, userID, id int) (*Goal, error) {
+	where := map[string]interface{}{
+		"id":      eq(id),
+		"user_id": eq(userID),
+	}
+	goals, err := c.getGoals(ctx, where)
+	if err != nil {
+		return nil, err
+	}
+	if len(goals) == 0 {
+		return nil, fmt.Errorf("goal %d: %w", id, ErrNotFound)
+	}
+	return &goals[0], nil
+}
+
+// getGoals executes the GetGoals query.
+func (c *Client) getGoals(ctx context.Context, where map[string]interface{}) ([]Goal, error) {
+	variables := map[string]interface{}{
+		"where":    where,
+		"order_by": []interface{}{map[string]interface{}{"end_date": "desc"}, map[string]interface{}{"id": "desc"}},
+	}
+	var response GetGoalsResponse
+	if err := c.Execute(ctx, GetGoalsQuery, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.Goals, nil
+}
+
+// AddGoal creates a reading goal for the current user.
+func (c *Client) AddGoal(ctx context.Context, input *GoalInput) (*Goal, error) {
+	if input.Metric == "" || input.Goal <= 0 || input.StartDate == "" || input.EndDate == "" {
+		return nil, errors.New("a metric, a positive goal and start and end dates are required")
+	}
+	goal := *input
+	if goal.Conditions == nil {
+		goal.Conditions = json.RawMessage("{}")
+	}
+
+	variables := map[string]interface{}{
+		"goal": &goal,
+	}
+	var response InsertGoalResponse
+	if err := c.Execute(ctx, InsertGoalMutation, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.InsertGoal.goal()
+}
+
+// UpdateGoal changes one of the current user's reading goals.
+func (c *Client) UpdateGoal(ctx context.Context, id int, input *GoalInput) (*Goal, error) {
+	variables := map[string]interface{}{
+		"id":   id,
+		"goal": input,
+	}
+	var response UpdateGoalResponse
+	if err := c.Execute(WithRetrySafe(ctx), UpdateGoalMutation, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.UpdateGoal.goal()
+}
+
+// UpdateGoalProgress has the API recalculate the progress of one of the
+// current user's reading goals, and returns the updated goal.
+func (c *Client) UpdateGoalProgress(ctx context.Context, id int) (*Goal, error) {
+	variables := map[string]interface{}{
+		"id": id,
+	}
+	var response UpdateGoalProgressResponse
+	if err := c.Execute(WithRetrySafe(ctx), UpdateGoalProgressMutation, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.UpdateGoalProgress.goal()
+}
+
+// goal returns the goal of a mutation result, or the errors the API
+// reported for it.
+func (r *GoalResult) goal() (*Goal, error) {
+	switch {
+	case r == nil:
+		return nil, errors.New("empty response from the API")
+	case len(r.Errors) > 0:
+		return nil, errors.New(strings.Join(r.Errors, "; "))
+	case r.Goal == nil:
+		return &Goal{ID: r.ID}, nil
+	default:
+		return r.Goal, nil
+	}
+}
@@ -2,8 +2,17 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"hardcover-cli/internal/isbn"
 )
 
+// pageSize is the number of records fetched per request when paging through
+// a full result set with limit and offset.
+const pageSize = 100
+
 // GetCurrentUser executes the GetCurrentUser query and returns the response.
 func (c *Client) GetCurrentUser(ctx context.Context) (*GetCurrentUserResponse, error) {
 	var response GetCurrentUserResponse
@@ -13,26 +22,165 @@ func (c *Client) GetCurrentUser(ctx context.Context) (*GetCurrentUserResponse, e
 	return &response, nil
 }
 
-// SearchBooks executes the SearchBooks query with the given search term.
-func (c *Client) SearchBooks(ctx context.Context, query string) (*SearchBooksResponse, error) {
-	variables := map[string]interface{}{
-		"query": query,
+// CurrentUserID fetches the ID of the user the API key belongs to.
+func (c *Client) CurrentUserID(ctx context.Context) (int, error) {
+	var response GetCurrentUserIDResponse
+	if err := c.Execute(ctx, GetCurrentUserIDQuery, nil, &response); err != nil {
+		return 0, err
 	}
-	var response SearchBooksResponse
-	if err := c.Execute(ctx, SearchBooksQuery, variables, &response); err != nil {
+	if len(response.Me) == 0 || response.Me[0].ID == 0 {
+		return 0, fmt.Errorf("no current user: %w", ErrUnauthorized)
+	}
+	return response.Me[0].ID, nil
+}
+
+// SearchBooks searches for books matching query.
+func (c *Client) SearchBooks(ctx context.Context, query string, opts *SearchOptions) (*SearchResults[BookDocument], error) {
+	return Search[BookDocument](ctx, c, query, opts)
+}
+
+// SearchUsers searches for users matching query.
+func (c *Client) SearchUsers(ctx context.Context, query string, opts *SearchOptions) (*SearchResults[UserDocument], error) {
+	return Search[UserDocument](ctx, c, query, opts)
+}
+
+// GetBook fetches a book by its Hardcover ID. It returns ErrNotFound when no
+// book has that ID.
+func (c *Client) GetBook(ctx context.Context, id int) (*BookDetail, error) {
+	return c.getBookWhere(ctx, map[string]interface{}{"id": eq(id)})
+}
+
+// GetBookBySlug fetches a book by its URL slug. It returns ErrNotFound when no
+// book has that slug.
+func (c *Client) GetBookBySlug(ctx context.Context, slug string) (*BookDetail, error) {
+	return c.getBookWhere(ctx, map[string]interface{}{"slug": eq(slug)})
+}
+
+// GetBookByISBN fetches the book with an edition matching either form of the
+// given ISBN. It returns ErrNotFound when no edition has that ISBN.
+func (c *Client) GetBookByISBN(ctx context.Context, number isbn.ISBN) (*BookDetail, error) {
+	var response GetBookByISBNResponse
+	if err := c.Execute(ctx, GetBookByISBNQuery, isbnVariables([]isbn.ISBN{number}), &response); err != nil {
 		return nil, err
 	}
-	return &response, nil
+	for _, edition := range response.Editions {
+		if edition.Book != nil {
+			return edition.Book, nil
+		}
+	}
+	return nil, fmt.Errorf("no edition with ISBN %s: %w", number, ErrNotFound)
 }
 
-// GetBook executes the GetBook query with the given book ID.
-func (c *Client) GetBook(ctx context.Context, id string) (*GetBookResponse, error) {
+// editionsByISBNBatchSize is the number of ISBNs looked up per request.
+const editionsByISBNBatchSize = 100
+
+// GetEditionsByISBN fetches the editions matching any of the given ISBNs,
+// most read first. Large inputs are split across several requests.
+func (c *Client) GetEditionsByISBN(ctx context.Context, numbers []isbn.ISBN) ([]EditionDetail, error) {
+	var editions []EditionDetail
+	for batch := range slices.Chunk(numbers, editionsByISBNBatchSize) {
+		var response GetEditionsResponse
+		if err := c.Execute(ctx, GetEditionsByISBNQuery, isbnVariables(batch), &response); err != nil {
+			return nil, err
+		}
+		editions = append(editions, response.Editions...)
+	}
+	return editions, nil
+}
+
+// isbnVariables builds the isbn_10 and isbn_13 list variables shared by the
+// ISBN queries.
+func isbnVariables(numbers []isbn.ISBN) map[string]interface{} {
+	isbn10s := []string{}
+	isbn13s := []string{}
+	for _, number := range numbers {
+		if number.ISBN10 != "" {
+			isbn10s = append(isbn10s, number.ISBN10)
+		}
+		isbn13s = append(isbn13s, number.ISBN13)
+	}
+	return map[string]interface{}{
+		"isbn_10": isbn10s,
+		"isbn_13": isbn13s,
+	}
+}
+
+// Matches reports whether the edition has either form of the given ISBN.
+func (e *EditionDetail) Matches(number isbn.ISBN) bool {
+	return (e.ISBN13 != "" && e.ISBN13 == number.ISBN13) || (e.ISBN10 != "" && e.ISBN10 == number.ISBN10)
+}
+
+// getBookWhere executes the GetBook query with a books_bool_exp filter.
+func (c *Client) getBookWhere(ctx context.Context, where map[string]interface{}) (*BookDetail, error) {
 	variables := map[string]interface{}{
-		"id": id,
+		"where": where,
 	}
 	var response GetBookResponse
 	if err := c.Execute(ctx, GetBookQuery, variables, &response); err != nil {
 		return nil, err
 	}
-	return &response, nil
+	if len(response.Books) == 0 {
+		return nil, fmt.Errorf("no matching book: %w", ErrNotFound)
+	}
+	return &response.Books[0], nil
+}
+
+// collectPages calls fetch with increasing offsets, pageSize records at a
+// time, until it returns a short page.
+func collectPages[T any](fetch func(offset int) ([]T, error)) ([]T, error) {
+	var records []T
+	for offset := 0; ; offset += pageSize {
+		page, err := fetch(offset)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, page...)
+		if len(page) < pageSize {
+			return records, nil
+		}
+	}
+}
+
+// orderByClause builds a single order_by entry for field, which must be one
+// of allowed. Rows without a value are listed last in either direction.
+func orderByClause(noun, field string, allowed []string, descending bool) (map[string]interface{}, error) {
+	if !slices.Contains(allowed, field) {
+		return nil, fmt.Errorf("cannot sort %s by %q (available: %s)", noun, field, strings.Join(allowed, ", "))
+	}
+	direction := "asc_nulls_last"
+	if descending {
+		direction = "desc_nulls_last"
+	}
+	return map[string]interface{}{field: direction}, nil
+}
+
+// eq builds an _eq comparison.
+func eq(value interface{}) map[string]interface{} {
+	return map[string]interface{}{"_eq": value}
+}
+
+// ilike builds a case-insensitive substring comparison.
+func ilike(value string) map[string]interface{} {
+	return map[string]interface{}{"_ilike": "%" + likeEscaper.Replace(value) + "%"}
+}
+
+// iequal builds a case-insensitive equality comparison.
+func iequal(value string) map[string]interface{} {
+	return map[string]interface{}{"_ilike": likeEscaper.Replace(value)}
+}
+
+// likeEscaper escapes the characters with a special meaning in _ilike
+// patterns, so that they match themselves.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// SplitList splits a comma-separated list, trimming whitespace and dropping
+// empty entries.
+func SplitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
@@ -0,0 +1,209 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ListInput holds the fields of a list to set. Zero and nil fields are left
+// unchanged.
+type ListInput struct {
+	Name             string         `json:"name,omitempty"`
+	Description      *string        `json:"description,omitempty"`
+	Ranked           *bool          `json:"ranked,omitempty"`
+	PrivacySettingID PrivacySetting `json:"privacy_setting_id,omitempty"`
+}
+
+// ListBookInput describes a book to add to a list. Position is only used by
+// ranked lists.
+type ListBookInput struct {
+	ListID    int     `json:"list_id"`
+	BookID    int     `json:"book_id"`
+	EditionID *int    `json:"edition_id,omitempty"`
+	Position  int     `json:"position,omitempty"`
+	Reason    *string `json:"reason,omitempty"`
+}
+
+// URL returns the list's page on Hardcover, or "" when it is unknown.
+func (l *List) URL() string {
+	if l.Slug == "" || l.User == nil || l.User.Username == "" {
+		return ""
+	}
+	return "https://hardcover.app/@" + l.User.Username + "/lists/" + l.Slug
+}
+
+// ListBookFor returns the entry for a book on the list, or nil when the book
+// is not on it.
+func (l *List) ListBookFor(bookID int) *ListBook {
+	for i := range l.ListBooks {
+		if l.ListBooks[i].BookID == bookID {
+			return &l.ListBooks[i]
+		}
+	}
+	return nil
+}
+
+// GetList fetches a list and its books by ID. It returns ErrNotFound when no
+// list has that ID.
+func (c *Client) GetList(ctx context.Context, id int) (*List, error) {
+	return c.getListWhere(ctx, map[string]interface{}{"id": eq(id)})
+}
+
+// GetListBySlug fetches one of a user's lists and its books by URL slug.
+// List slugs are only unique per user. It returns ErrNotFound when the user
+// has no list with that slug.
+func (c *Client) GetListBySlug(ctx context.Context, userID int, slug string) (*List, error) {
+	return c.getListWhere(ctx, map[string]interface{}{
+		"slug":    eq(slug),
+		"user_id": eq(userID),
+	})
+}
+
+// GetLists fetches every list a user has made, with their books, oldest
+// first, requesting them a page at a time.
+func (c *Client) GetLists(ctx context.Context, userID int) ([]List, error) {
+	return collectPages(func(offset int) ([]List, error) {
+		variables := map[string]interface{}{
+			"where":  map[string]interface{}{"user_id": eq(userID)},
+			"limit":  pageSize,
+			"offset": offset,
+		}
+		var response GetListsResponse
+		if err := c.Execute(ctx, GetListsQuery, variables, &response); err != nil {
+			return nil, err
+		}
+		return response.Lists, nil
+	})
+}
+
+// getListWhere executes the GetList query with a lists_bool_exp filter.
+func (c *Client) getListWhere(ctx context.Context, where map[string]interface{}) (*List, error) {
+	variables := map[string]interface{}{
+		"where": where,
+	}
+	var response GetListResponse
+	if err := c.Execute(ctx, GetListQuery, variables, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Lists) == 0 {
+		return nil, fmt.Errorf("no matching list: %w", ErrNotFound)
+	}
+	return &response.Lists[0], nil
+}
+
+// AddList creates a list for the current user.
+func (c *Client) AddList(ctx context.Context, input *ListInput) (*List, error) {
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, errors.New("a list name is required")
+	}
+
+	variables := map[string]interface{}{
+		"object": input,
+	}
+	var response InsertListResponse
+	if err := c.Execute(ctx, InsertListMutation, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.InsertList.list()
+}
+
+// UpdateList changes one of the current user's lists.
+func (c *Client) UpdateList(ctx context.Context, id int, input *ListInput) (*List, error) {
+	variables := map[string]interface{}{
+		"id":     id,
+		"object": input,
+	}
+	var response UpdateListResponse
+	if err := c.Execute(WithRetrySafe(ctx), UpdateListMutation, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.UpdateList.list()
+}
+
+// DeleteList removes one of the current user's lists and its books.
+func (c *Client) DeleteList(ctx context.Context, id int) error {
+	variables := map[string]interface{}{
+		"id": id,
+	}
+	var response DeleteListResponse
+	if err := c.Execute(ctx, DeleteListMutation, variables, &response); err != nil {
+		return err
+	}
+	if response.DeleteList == nil || !response.DeleteList.Success {
+		return fmt.Errorf("list %d was not deleted", id)
+	}
+	return nil
+}
+
+// AddListBook adds a book to one of the current user's lists.
+func (c *Client) AddListBook(ctx context.Context, input *ListBookInput) (*ListBook, error) {
+	if input.ListID == 0 || input.BookID == 0 {
+		return nil, errors.New("a list ID and a book ID are required")
+	}
+
+	variables := map[string]interface{}{
+		"object": input,
+	}
+	var response InsertListBookResponse
+	if err := c.Execute(ctx, InsertListBookMutation, variables, &response); err != nil {
+		return nil, err
+	}
+	switch result := response.InsertListBook; {
+	case result == nil:
+		return nil, errors.New("empty response from the API")
+	case result.ListBook == nil:
+		return &ListBook{ID: result.ID, ListID: input.ListID, BookID: input.BookID, Position: input.Position}, nil
+	default:
+		return result.ListBook, nil
+	}
+}
+
+// RemoveListBook removes a book, by list_books ID, from one of the current
+// user's lists.
+func (c *Client) RemoveListBook(ctx context.Context, id int) error {
+	variables := map[string]interface{}{
+		"id": id,
+	}
+	var response DeleteListBookResponse
+	if err := c.Execute(ctx, DeleteListBookMutation, variables, &response); err != nil {
+		return err
+	}
+	if response.DeleteListBook == nil {
+		return fmt.Errorf("list book %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+// SetListBookPosition moves a book, by list_books ID, to a position on one
+// of the current user's ranked lists. Other books keep their positions.
+func (c *Client) SetListBookPosition(ctx context.Context, id, position int) error {
+	variables := map[string]interface{}{
+		"id":       id,
+		"position": position,
+	}
+	var response UpdateListBookPositionResponse
+	if err := c.Execute(WithRetrySafe(ctx), UpdateListBookPositionMutation, variables, &response); err != nil {
+		return err
+	}
+	if response.UpdateListBooks == nil || response.UpdateListBooks.AffectedRows == 0 {
+		return fmt.Errorf("list book %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+// list returns the list of a mutation result, or the errors the API
+// reported for it.
+func (r *ListResult) list() (*List, error) {
+	switch {
+	case r == nil:
+		return nil, errors.New("empty response from the API")
+	case len(r.Errors) > 0:
+		return nil, errors.New(strings.Join(r.Errors, "; "))
+	case r.List == nil:
+		return &List{ID: r.ID}, nil
+	default:
+		return r.List, nil
+	}
+}
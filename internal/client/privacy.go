@@ -0,0 +1,61 @@
+package client
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PrivacySetting is the id of a privacy_settings row: who can see a journal
+// entry, list or other user content.
+type PrivacySetting int
+
+// Privacy settings defined by privacy_settings.
+const (
+	PrivacyPublic    PrivacySetting = 1
+	PrivacyFollowers PrivacySetting = 2
+	PrivacyPrivate   PrivacySetting = 3
+)
+
+// privacySettingNames holds the slugs of the known privacy settings in id
+// order.
+var privacySettingNames = []struct {
+	setting PrivacySetting
+	name    string
+}{
+	{PrivacyPublic, "public"},
+	{PrivacyFollowers, "followers"},
+	{PrivacyPrivate, "private"},
+}
+
+// String returns the setting's slug, e.g. "followers".
+func (p PrivacySetting) String() string {
+	for _, entry := range privacySettingNames {
+		if entry.setting == p {
+			return entry.name
+		}
+	}
+	return "privacy " + strconv.Itoa(int(p))
+}
+
+// PrivacySettingNames lists the slugs of the known privacy settings in id
+// order.
+func PrivacySettingNames() []string {
+	names := make([]string, 0, len(privacySettingNames))
+	for _, entry := range privacySettingNames {
+		names = append(names, entry.name)
+	}
+	return names
+}
+
+// ParsePrivacySetting parses a privacy setting given by slug or id,
+// ignoring case.
+func ParsePrivacySetting(value string) (PrivacySetting, error) {
+	key := strings.ToLower(strings.TrimSpace(value))
+	for _, entry := range privacySettingNames {
+		if key == entry.name || key == strconv.Itoa(int(entry.setting)) {
+			return entry.setting, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown privacy setting %q (available: %s)", value, strings.Join(PrivacySettingNames(), ", "))
+}
@@ -18,60 +18,751 @@ query GetCurrentUser {
 }
 `
 
-	// SearchBooksQuery searches for books by query string.
-	SearchBooksQuery = `
-query SearchBooks($query: String!) {
-  search(query: $query, type: BOOKS) {
-    ... on BookSearchResults {
-      totalCount
-      results {
-        ... on Book {
-          id
-          title
-          slug
-          isbn
-          publicationYear
-          pageCount
-          cached_contributors {
-            name
-            role
-          }
-          cached_genres {
-            name
-          }
-          image
-          averageRating
-          ratingsCount
-        }
-      }
-    }
+	// SearchQuery runs a Typesense-backed search for a single query type.
+	SearchQuery = `
+query Search(
+  $query: String!
+  $query_type: String!
+  $per_page: Int!
+  $page: Int!
+  $sort: String
+  $fields: String
+  $weights: String
+) {
+  search(
+    query: $query
+    query_type: $query_type
+    per_page: $per_page
+    page: $page
+    sort: $sort
+    fields: $fields
+    weights: $weights
+  ) {
+    error
+    ids
+    page
+    per_page
+    query
+    query_type
+    results
   }
 }
 `
 
-	// GetBookQuery fetches a specific book by ID.
+	// GetBookQuery fetches the full detail of the first book matching a
+	// books_bool_exp filter, e.g. {"id": {"_eq": 123}} or
+	// {"slug": {"_eq": "dune"}}.
 	GetBookQuery = `
-query GetBook($id: ID!) {
-  book(id: $id) {
+query GetBook($where: books_bool_exp!) {
+  books(where: $where, limit: 1) {
+    ...BookDetail
+  }
+}
+` + bookDetailFragment
+
+	// GetBookByISBNQuery fetches the book that owns the most read edition
+	// with one of the given ISBN-10s or ISBN-13s.
+	GetBookByISBNQuery = `
+query GetBookByISBN($isbn_10: [String!]!, $isbn_13: [String!]!) {
+  editions(
+    where: {_or: [{isbn_13: {_in: $isbn_13}}, {isbn_10: {_in: $isbn_10}}]}
+    order_by: {users_count: desc}
+    limit: 1
+  ) {
+    book {
+      ...BookDetail
+    }
+  }
+}
+` + bookDetailFragment
+
+	// GetEditionsByISBNQuery fetches every edition with one of the given
+	// ISBN-10s or ISBN-13s, together with its parent book.
+	GetEditionsByISBNQuery = `
+query GetEditionsByISBN($isbn_10: [String!]!, $isbn_13: [String!]!) {
+  editions(
+    where: {_or: [{isbn_13: {_in: $isbn_13}}, {isbn_10: {_in: $isbn_10}}]}
+    order_by: {users_count: desc}
+  ) {
+    ...EditionDetail
+  }
+}
+` + editionDetailFragment
+
+	// GetEditionsQuery fetches a page of editions matching an
+	// editions_bool_exp filter.
+	GetEditionsQuery = `
+query GetEditions($where: editions_bool_exp!, $order_by: [editions_order_by!], $limit: Int!, $offset: Int!) {
+  editions(where: $where, order_by: $order_by, limit: $limit, offset: $offset) {
+    ...EditionDetail
+  }
+}
+` + editionDetailFragment
+
+	// bookDetailFragment selects the fields shown by book show.
+	bookDetailFragment = `
+fragment BookDetail on books {
+  id
+  title
+  subtitle
+  slug
+  description
+  pages
+  release_date
+  release_year
+  rating
+  ratings_count
+  ratings_distribution
+  reviews_count
+  users_count
+  users_read_count
+  editions_count
+  cached_tags
+  contributions {
+    contribution
+    author {
+      id
+      name
+      slug
+    }
+  }
+  book_series {
+    position
+    details
+    featured
+    series {
+      id
+      name
+      slug
+      books_count
+      primary_books_count
+    }
+  }
+}
+`
+
+	// editionDetailFragment selects the fields shown for an edition.
+	editionDetailFragment = `
+fragment EditionDetail on editions {
+  id
+  title
+  subtitle
+  isbn_10
+  isbn_13
+  asin
+  edition_format
+  edition_information
+  physical_format
+  pages
+  release_date
+  audio_seconds
+  users_count
+  publisher {
+    id
+    name
+  }
+  language {
+    language
+    code2
+    code3
+  }
+  reading_format {
+    format
+  }
+  book {
     id
     title
-    description
     slug
-    isbn
-    publicationYear
-    pageCount
-    cached_contributors {
+    release_year
+    rating
+    contributions {
+      contribution
+      author {
+        id
+        name
+        slug
+      }
+    }
+  }
+}
+`
+
+	// GetAuthorQuery fetches the profile of the first author matching an
+	// authors_bool_exp filter.
+	GetAuthorQuery = `
+query GetAuthor($where: authors_bool_exp!) {
+  authors(where: $where, limit: 1) {
+    id
+    name
+    name_personal
+    slug
+    bio
+    born_year
+    death_year
+    born_date
+    death_date
+    alternate_names
+    location
+    books_count
+    users_count
+  }
+}
+`
+
+	// GetContributionsQuery fetches a page of book contributions matching a
+	// contributions_bool_exp filter.
+	GetContributionsQuery = `
+query GetContributions(
+  $where: contributions_bool_exp!
+  $order_by: [contributions_order_by!]
+  $limit: Int!
+  $offset: Int!
+) {
+  contributions(where: $where, order_by: $order_by, limit: $limit, offset: $offset) {
+    contribution
+    author {
+      id
       name
-      role
+      slug
+    }
+    book {
+      id
+      title
+      slug
+      release_year
+      rating
+      ratings_count
+      users_count
     }
-    cached_genres {
+  }
+}
+`
+
+	// GetSeriesQuery fetches the first series matching a series_bool_exp
+	// filter together with its books. Books merged into another book are
+	// skipped.
+	GetSeriesQuery = `
+query GetSeries($where: series_bool_exp!) {
+  series(where: $where, limit: 1) {
+    id
+    name
+    slug
+    description
+    books_count
+    primary_books_count
+    is_completed
+    author {
+      id
       name
+      slug
+    }
+    book_series(
+      where: {book: {canonical_id: {_is_null: true}}}
+      order_by: [{position: asc_nulls_last}, {book: {release_year: asc_nulls_last}}]
+    ) {
+      position
+      details
+      book {
+        id
+        title
+        slug
+        release_year
+        pages
+        rating
+        users_count
+        compilation
+      }
+    }
+  }
+}
+`
+
+	// GetMyUserBooksQuery fetches the current user's shelf entries for the
+	// given books.
+	GetMyUserBooksQuery = `
+query GetMyUserBooks($book_ids: [Int!]!) {
+  me {
+    user_books(where: {book_id: {_in: $book_ids}}) {
+      ...UserBook
+    }
+  }
+}
+` + userBookFragment
+
+	// GetCurrentUserIDQuery fetches the current user's ID.
+	GetCurrentUserIDQuery = `
+query GetCurrentUserID {
+  me {
+    id
+  }
+}
+`
+
+	// GetUserBooksQuery fetches a page of shelf entries matching a
+	// user_books_bool_exp filter.
+	GetUserBooksQuery = `
+query GetUserBooks(
+  $where: user_books_bool_exp!
+  $order_by: [user_books_order_by!]
+  $limit: Int!
+  $offset: Int!
+) {
+  user_books(where: $where, order_by: $order_by, limit: $limit, offset: $offset) {
+    ...UserBook
+  }
+}
+` + userBookFragment
+
+	// GetEditionQuery fetches the first edition matching an editions_bool_exp
+	// filter.
+	GetEditionQuery = `
+query GetEdition($where: editions_bool_exp!) {
+  editions(where: $where, limit: 1) {
+    ...EditionDetail
+  }
+}
+` + editionDetailFragment
+
+	// InsertUserBookMutation adds a book to the current user's shelves.
+	InsertUserBookMutation = `
+mutation InsertUserBook($object: UserBookCreateInput!) {
+  insert_user_book(object: $object) {
+    id
+    error
+    user_book {
+      ...UserBook
+    }
+  }
+}
+` + userBookFragment
+
+	// UpdateUserBookMutation changes one of the current user's shelf entries.
+	UpdateUserBookMutation = `
+mutation UpdateUserBook($id: Int!, $object: UserBookUpdateInput!) {
+  update_user_book(id: $id, object: $object) {
+    id
+    error
+    user_book {
+      ...UserBook
+    }
+  }
+}
+` + userBookFragment
+
+	// InsertUserBookReadMutation records a new read of a shelf entry.
+	InsertUserBookReadMutation = `
+mutation InsertUserBookRead($user_book_id: Int!, $user_book_read: DatesReadInput!) {
+  insert_user_book_read(user_book_id: $user_book_id, user_book_read: $user_book_read) {
+    id
+    error
+    user_book_read {
+      ...UserBookRead
+    }
+  }
+}
+` + userBookReadFragment
+
+	// UpdateUserBookReadMutation changes a read of a shelf entry.
+	UpdateUserBookReadMutation = `
+mutation UpdateUserBookRead($id: Int!, $object: DatesReadInput!) {
+  update_user_book_read(id: $id, object: $object) {
+    id
+    error
+    user_book_read {
+      ...UserBookRead
+    }
+  }
+}
+` + userBookReadFragment
+
+	// DeleteUserBookMutation removes one of the current user's shelf entries.
+	DeleteUserBookMutation = `
+mutation DeleteUserBook($id: Int!) {
+  delete_user_book(id: $id) {
+    id
+    book_id
+  }
+}
+`
+
+	// GetReadingJournalsQuery fetches a page of journal entries matching a
+	// reading_journals_bool_exp filter.
+	GetReadingJournalsQuery = `
+query GetReadingJournals(
+  $where: reading_journals_bool_exp!
+  $order_by: [reading_journals_order_by!]
+  $limit: Int!
+  $offset: Int!
+) {
+  reading_journals(where: $where, order_by: $order_by, limit: $limit, offset: $offset) {
+    ...ReadingJournal
+  }
+}
+` + readingJournalFragment
+
+	// GetReadingJournalsSummaryQuery fetches a user's journal entry counts
+	// per book, most recently updated first.
+	GetReadingJournalsSummaryQuery = `
+query GetReadingJournalsSummary($user_id: Int!) {
+  reading_journals_summary(
+    where: {user_id: {_eq: $user_id}}
+    order_by: {last_updated_at: desc_nulls_last}
+  ) {
+    book_id
+    journals_count
+    last_updated_at
+    book {
+      id
+      title
+      slug
+    }
+  }
+}
+`
+
+	// InsertReadingJournalMutation adds an entry to the current user's
+	// reading journal.
+	InsertReadingJournalMutation = `
+mutation InsertReadingJournal($object: ReadingJournalCreateType!) {
+  insert_reading_journal(object: $object) {
+    id
+    errors
+    reading_journal {
+      ...ReadingJournal
+    }
+  }
+}
+` + readingJournalFragment
+
+	// UpdateReadingJournalMutation changes an entry of the current user's
+	// reading journal.
+	UpdateReadingJournalMutation = `
+mutation UpdateReadingJournal($id: Int!, $object: ReadingJournalUpdateType!) {
+  update_reading_journal(id: $id, object: $object) {
+    id
+    errors
+    reading_journal {
+      ...ReadingJournal
+    }
+  }
+}
+` + readingJournalFragment
+
+	// DeleteReadingJournalMutation removes an entry from the current user's
+	// reading journal.
+	DeleteReadingJournalMutation = `
+mutation DeleteReadingJournal($id: Int!) {
+  delete_reading_journal(id: $id) {
+    id
+  }
+}
+`
+
+	// GetGoalsQuery fetches a user's reading goals matching a goals_bool_exp
+	// filter.
+	GetGoalsQuery = `
+query GetGoals($where: goals_bool_exp!, $order_by: [goals_order_by!]) {
+  goals(where: $where, order_by: $order_by) {
+    ...Goal
+  }
+}
+` + goalFragment
+
+	// InsertGoalMutation creates a reading goal for the current user.
+	InsertGoalMutation = `
+mutation InsertGoal($goal: GoalInput!) {
+  insert_goal(goal: $goal) {
+    id
+    errors
+    goal {
+      ...Goal
+    }
+  }
+}
+` + goalFragment
+
+	// UpdateGoalMutation changes one of the current user's reading goals.
+	UpdateGoalMutation = `
+mutation UpdateGoal($id: Int!, $goal: GoalInput!) {
+  update_goal(id: $id, goal: $goal) {
+    id
+    errors
+    goal {
+      ...Goal
+    }
+  }
+}
+` + goalFragment
+
+	// UpdateGoalProgressMutation recalculates the progress of one of the
+	// current user's reading goals from their reads.
+	UpdateGoalProgressMutation = `
+mutation UpdateGoalProgress($id: Int!) {
+  update_goal_progress(id: $id) {
+    id
+    errors
+    goal {
+      ...Goal
+    }
+  }
+}
+` + goalFragment
+
+	// GetListQuery fetches a list and its books, in list order, matching a
+	// lists_bool_exp filter.
+	GetListQuery = `
+query GetList($where: lists_bool_exp!) {
+  lists(where: $where, limit: 1) {
+    ...List
+    list_books(order_by: [{position: asc_nulls_last}, {id: asc}]) {
+      ...ListBook
+    }
+  }
+}
+` + listFragment + listBookFragment
+
+	// GetListsQuery fetches a page of lists and their books, in list order,
+	// matching a lists_bool_exp filter.
+	GetListsQuery = `
+query GetLists($where: lists_bool_exp!, $limit: Int!, $offset: Int!) {
+  lists(where: $where, order_by: {id: asc}, limit: $limit, offset: $offset) {
+    ...List
+    list_books(order_by: [{position: asc_nulls_last}, {id: asc}]) {
+      ...ListBook
+    }
+  }
+}
+` + listFragment + listBookFragment
+
+	// InsertListMutation creates a list for the current user.
+	InsertListMutation = `
+mutation InsertList($object: ListInput!) {
+  insert_list(object: $object) {
+    id
+    errors
+    list {
+      ...List
+    }
+  }
+}
+` + listFragment
+
+	// UpdateListMutation changes one of the current user's lists.
+	UpdateListMutation = `
+mutation UpdateList($id: Int!, $object: ListInput!) {
+  update_list(id: $id, object: $object) {
+    id
+    errors
+    list {
+      ...List
+    }
+  }
+}
+` + listFragment
+
+	// DeleteListMutation removes one of the current user's lists.
+	DeleteListMutation = `
+mutation DeleteList($id: Int!) {
+  delete_list(id: $id) {
+    success
+  }
+}
+`
+
+	// InsertListBookMutation adds a book to one of the current user's lists.
+	InsertListBookMutation = `
+mutation InsertListBook($object: ListBookInput!) {
+  insert_list_book(object: $object) {
+    id
+    list_book {
+      ...ListBook
+    }
+  }
+}
+` + listBookFragment
+
+	// DeleteListBookMutation removes a book from one of the current user's
+	// lists.
+	DeleteListBookMutation = `
+mutation DeleteListBook($id: Int!) {
+  delete_list_book(id: $id) {
+    id
+    list_id
+  }
+}
+`
+
+	// UpdateListBookPositionMutation moves a book of a ranked list to a new
+	// position.
+	UpdateListBookPositionMutation = `
+mutation UpdateListBookPosition($id: Int!, $position: Int!) {
+  update_list_books(where: {id: {_eq: $id}}, _set: {position: $position}) {
+    affected_rows
+  }
+}
+`
+
+	// listFragment selects a list with its owner.
+	listFragment = `
+fragment List on lists {
+  id
+  name
+  slug
+  description
+  ranked
+  public
+  privacy_setting_id
+  books_count
+  user_id
+  user {
+    username
+  }
+}
+`
+
+	// listBookFragment selects a book of a list.
+	listBookFragment = `
+fragment ListBook on list_books {
+  id
+  list_id
+  book_id
+  edition_id
+  position
+  reason
+  date_added
+  book {
+    id
+    title
+    slug
+    release_year
+    contributions {
+      contribution
+      author {
+        id
+        name
+        slug
+      }
+    }
+  }
+}
+`
+
+	// goalFragment selects a reading goal.
+	goalFragment = `
+fragment Goal on goals {
+  id
+  metric
+  goal
+  progress
+  description
+  start_date
+  end_date
+  completed_at
+  state
+  archived
+  conditions
+  privacy_setting_id
+}
+`
+
+	// readingJournalFragment selects a journal entry with its book.
+	readingJournalFragment = `
+fragment ReadingJournal on reading_journals {
+  id
+  book_id
+  edition_id
+  event
+  entry
+  metadata
+  privacy_setting_id
+  created_at
+  updated_at
+  book {
+    id
+    title
+    slug
+  }
+}
+`
+
+	// userBookFragment selects a shelf entry with its book and edition.
+	userBookFragment = `
+fragment UserBook on user_books {
+  id
+  book_id
+  edition_id
+  status_id
+  rating
+  owned
+  starred
+  date_added
+  privacy_setting_id
+  last_read_date
+  review_raw
+  review_has_spoilers
+  reviewed_at
+  private_notes
+  reading_format_id
+  reading_format {
+    format
+  }
+  book {
+    id
+    title
+    slug
+    release_year
+    rating
+    pages
+    contributions {
+      contribution
+      author {
+        id
+        name
+        slug
+      }
+    }
+  }
+  edition {
+    id
+    title
+    edition_format
+    physical_format
+    isbn_10
+    isbn_13
+    pages
+    audio_seconds
+    reading_format {
+      format
+    }
+  }
+  user_book_reads(order_by: {id: asc}) {
+    ...UserBookRead
+  }
+}
+` + userBookReadFragment
+
+	// userBookReadFragment selects a read of a shelf entry with its edition.
+	userBookReadFragment = `
+fragment UserBookRead on user_book_reads {
+  id
+  user_book_id
+  edition_id
+  started_at
+  paused_at
+  finished_at
+  progress
+  progress_pages
+  progress_seconds
+  edition {
+    id
+    title
+    edition_format
+    physical_format
+    pages
+    audio_seconds
+    reading_format {
+      format
     }
-    image
-    averageRating
-    ratingsCount
-    createdAt
-    updatedAt
   }
 }
 `
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimit is Hardcover's documented budget of requests per minute.
+const DefaultRateLimit = 60
+
+// Tuning of the rate limiter.
+const (
+	// burstFraction is the share of the per-minute budget that may be
+	// spent at once.
+	burstFraction = 6
+	// epochThreshold separates reset headers given in seconds from those
+	// given as Unix times.
+	epochThreshold = 1_000_000_000
+)
+
+// rateLimiter is a token bucket shared by every request a client makes. A
+// sixth of the budget may be spent at once, and the bucket refills with the
+// rest over a minute, so no minute ever sees more requests than the budget.
+type rateLimiter struct {
+	mu sync.Mutex
+	// rate is the refill rate in tokens per second.
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	// pausedUntil holds back every request until the server's window
+	// resets, once it has said no requests remain.
+	pausedUntil time.Time
+	now         func() time.Time
+}
+
+// WithRateLimit limits the client to perMinute requests a minute, shared
+// by every goroutine using it. Zero removes the limit.
+func WithRateLimit(perMinute int) Option {
+	return func(c *Client) {
+		c.limiter = nil
+		if perMinute > 0 {
+			c.limiter = newRateLimiter(perMinute)
+		}
+	}
+}
+
+// newRateLimiter returns a limiter allowing perMinute requests a minute.
+func newRateLimiter(perMinute int) *rateLimiter {
+	burst := max(float64(perMinute/burstFraction), 1)
+	refill := max(float64(perMinute)-burst, 1)
+	return &rateLimiter{
+		rate:   refill / time.Minute.Seconds(),
+		burst:  burst,
+		tokens: burst,
+		now:    time.Now,
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before
+// making its request.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+	l.tokens--
+
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	if paused := l.pausedUntil.Sub(now); paused > wait {
+		wait = paused
+	}
+	return wait
+}
+
+// refill adds the tokens earned since the last call.
+func (l *rateLimiter) refill(now time.Time) {
+	if !l.last.IsZero() {
+		l.tokens = min(l.tokens+now.Sub(l.last).Seconds()*l.rate, l.burst)
+	}
+	l.last = now
+}
+
+// observe adapts the limiter to the rate limit headers of a response: the
+// bucket never holds more tokens than the server says remain, and once none
+// remain requests wait for the reset. A 429 response pauses requests for
+// its Retry-After wait.
+func (l *rateLimiter) observe(resp *http.Response) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if after := parseRetryAfter(resp.Header.Get("Retry-After"), now); after > 0 {
+			l.pause(now.Add(after))
+		}
+	}
+
+	remaining, ok := rateLimitHeader(resp.Header, "Remaining")
+	if !ok {
+		return
+	}
+	l.tokens = min(l.tokens, float64(remaining))
+	if remaining > 0 {
+		return
+	}
+	if reset, ok := rateLimitHeader(resp.Header, "Reset"); ok {
+		l.pause(resetTime(reset, now))
+	}
+}
+
+// pause holds back requests until at least until.
+func (l *rateLimiter) pause(until time.Time) {
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// rateLimitHeader reads a rate limit header in either its X-RateLimit- or
+// its RateLimit- form.
+func rateLimitHeader(header http.Header, name string) (int, bool) {
+	for _, key := range []string{"X-RateLimit-" + name, "RateLimit-" + name} {
+		if value, err := strconv.Atoi(header.Get(key)); err == nil && value >= 0 {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+// resetTime converts a reset header, in seconds from now or as a Unix time,
+// to a time.
+func resetTime(reset int, now time.Time) time.Time {
+	if reset >= epochThreshold {
+		return time.Unix(int64(reset), 0)
+	}
+	return now.Add(time.Duration(reset) * time.Second)
+}
@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+)
+
+// Journal events written by the CLI. The API records others itself, such
+// as "progress_updated" or "status_read".
+const (
+	JournalEventNote  = "note"
+	JournalEventQuote = "quote"
+)
+
+// journalPageKey is the metadata key holding the page a journal entry
+// refers to.
+const journalPageKey = "page"
+
+// Page returns the page the entry refers to, or zero.
+func (j *ReadingJournal) Page() int {
+	page, ok := j.Metadata[journalPageKey].(float64)
+	if !ok {
+		return 0
+	}
+	return int(page)
+}
+
+// ReadingJournalInput holds the fields of a journal entry to set. Zero and
+// nil fields are left unchanged; BookID and Event are only used when adding
+// an entry.
+type ReadingJournalInput struct {
+	BookID           int             `json:"book_id,omitempty"`
+	EditionID        *int            `json:"edition_id,omitempty"`
+	Event            string          `json:"event,omitempty"`
+	Entry            *string         `json:"entry,omitempty"`
+	PrivacySettingID PrivacySetting  `json:"privacy_setting_id,omitempty"`
+	Metadata         json.RawMessage `json:"metadata,omitempty"`
+}
+
+// SetPage sets the metadata of the input to metadata, which may be nil, with
+// its page replaced by page, or removed when page is zero.
+func (in *ReadingJournalInput) SetPage(metadata map[string]interface{}, page int) error {
+	updated := maps.Clone(metadata)
+	if updated == nil {
+		updated = make(map[string]interface{})
+	}
+	if page > 0 {
+		updated[journalPageKey] = page
+	} else {
+		delete(updated, journalPageKey)
+	}
+
+	data, err := json.Marshal(updated)
+	if err != nil {
+		return err
+	}
+	in.Metadata = data
+	return nil
+}
+
+// IsEmpty reports whether the input changes nothing.
+func (in *ReadingJournalInput) IsEmpty() bool {
+	return in.BookID == 0 && in.EditionID == nil && in.Event == "" && in.Entry == nil &&
+		in.PrivacySettingID == 0 && in.Metadata == nil
+}
+
+// ReadingJournalFilter selects the journal entries returned by
+// GetReadingJournals. Zero values leave a criterion out.
+type ReadingJournalFilter struct {
+	UserID int
+	BookID int
+	Events []string
+}
+
+// where builds the reading_journals_bool_exp for the filter.
+func (f *ReadingJournalFilter) where() (map[string]interface{}, error) {
+	if f.UserID == 0 {
+		return nil, errors.New("a user ID is required")
+	}
+	conditions := []interface{}{
+		map[string]interface{}{"user_id": eq(f.UserID)},
+	}
+	if f.BookID != 0 {
+		conditions = append(conditions, map[string]interface{}{"book_id": eq(f.BookID)})
+	}
+	if len(f.Events) > 0 {
+		conditions = append(conditions, map[string]interface{}{"event": map[string]interface{}{"_in": f.Events}})
+	}
+	return map[string]interface{}{"_and": conditions}, nil
+}
+
+// GetReadingJournals fetches every journal entry matching the filter, oldest
+// first, requesting them a page at a time.
+func (c *Client) GetReadingJournals(ctx context.Context, filter *ReadingJournalFilter) ([]ReadingJournal, error) {
+	where, err := filter.where()
+	if err != nil {
+		return nil, err
+	}
+	return collectPages(func(offset int) ([]ReadingJournal, error) {
+		return c.getReadingJournals(ctx, where, pageSize, offset)
+	})
+}
+
+// GetReadingJournal fetches one of a user's journal entries. It returns
+// ErrNotFound when the user has no entry with that ID.
+func (c *Client) GetReadingJournal(ctx context.Context, userID, id int) (*ReadingJournal, error) {
+	where := map[string]interface{}{
+		"id":      eq(id),
+		"user_id": eq(userID),
+	}
+	journals, err := c.getReadingJournals(ctx, where, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(journals) == 0 {
+		return nil, fmt.Errorf("journal entry %d: %w", id, ErrNotFound)
+	}
+	return &journals[0], nil
+}
+
+// getReadingJournals executes the GetReadingJournals query.
+func (c *Client) getReadingJournals(
+	ctx context.Context, where map[string]interface{}, limit, offset int,
+) ([]ReadingJournal, error) {
+	variables := map[string]interface{}{
+		"where":    where,
+		"order_by": []interface{}{map[string]interface{}{"created_at": "asc"}, map[string]interface{}{"id": "asc"}},
+		"limit":    limit,
+		"offset":   offset,
+	}
+	var response GetReadingJournalsResponse
+	if err := c.Execute(ctx, GetReadingJournalsQuery, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.ReadingJournals, nil
+}
+
+// GetReadingJournalsSummary fetches a user's journal entry counts per book,
+// most recently updated first.
+func (c *Client) GetReadingJournalsSummary(ctx context.Context, userID int) ([]ReadingJournalSummary, error) {
+	variables := map[string]interface{}{
+		"user_id": userID,
+	}
+	var response GetReadingJournalsSummaryResponse
+	if err := c.Execute(ctx, GetReadingJournalsSummaryQuery, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.ReadingJournalsSummary, nil
+}
+
+// AddReadingJournal adds an entry to the current user's reading journal.
+func (c *Client) AddReadingJournal(ctx context.Context, input *ReadingJournalInput) (*ReadingJournal, error) {
+	if input.BookID == 0 || input.Event == "" {
+		return nil, errors.New("a book ID and an event are required")
+	}
+
+	variables := map[string]interface{}{
+		"object": input,
+	}
+	var response InsertReadingJournalResponse
+	if err := c.Execute(ctx, InsertReadingJournalMutation, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.InsertReadingJournal.readingJournal()
+}
+
+// UpdateReadingJournal changes an entry of the current user's reading
+// journal.
+func (c *Client) UpdateReadingJournal(ctx context.Context, id int, input *ReadingJournalInput) (*ReadingJournal, error) {
+	variables := map[string]interface{}{
+		"id":     id,
+		"object": input,
+	}
+	var response UpdateReadingJournalResponse
+	if err := c.Execute(WithRetrySafe(ctx), UpdateReadingJournalMutation, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.UpdateReadingJournal.readingJournal()
+}
+
+// DeleteReadingJournal removes an entry from the current user's reading
+// journal.
+func (c *Client) DeleteReadingJournal(ctx context.Context, id int) error {
+	variables := map[string]interface{}{
+		"id": id,
+	}
+	var response DeleteReadingJournalResponse
+	if err := c.Execute(ctx, DeleteReadingJournalMutation, variables, &response); err != nil {
+		return err
+	}
+	if response.DeleteReadingJournal == nil {
+		return fmt.Errorf("journal entry %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+// readingJournal returns the entry of a mutation result, or the errors the
+// API reported for it.
+func (r *ReadingJournalResult) readingJournal() (*ReadingJournal, error) {
+	switch {
+	case r == nil:
+		return nil, errors.New("empty response from the API")
+	case len(r.Errors) > 0:
+		return nil, errors.New(strings.Join(r.Errors, "; "))
+	case r.ReadingJournal == nil:
+		return &ReadingJournal{ID: r.ID}, nil
+	default:
+		return r.ReadingJournal, nil
+	}
+}
@@ -1,5 +1,7 @@
 package client
 
+import "encoding/json"
+
 // Response types for GraphQL queries
 
 // GetCurrentUserResponse represents the response from the GetCurrentUser query.
@@ -7,18 +9,793 @@ type GetCurrentUserResponse struct {
 	Me *Users `json:"me"`
 }
 
-// SearchBooksResponse represents the response from the SearchBooks query.
-type SearchBooksResponse struct {
-	Search *BookSearchResults `json:"search"`
+// GetBookResponse represents the response from the GetBook query.
+type GetBookResponse struct {
+	Books []BookDetail `json:"books"`
 }
 
-// BookSearchResults represents the search results for books.
-type BookSearchResults struct {
-	Results    []*Books `json:"results"`
-	TotalCount int      `json:"totalCount"`
+// GetBookByISBNResponse represents the response from the GetBookByISBN query.
+type GetBookByISBNResponse struct {
+	Editions []struct {
+		Book *BookDetail `json:"book"`
+	} `json:"editions"`
 }
 
-// GetBookResponse represents the response from the GetBook query.
-type GetBookResponse struct {
-	Book *Books `json:"book"`
+// GetEditionsResponse represents the response from the GetEdition,
+// GetEditions and GetEditionsByISBN queries.
+type GetEditionsResponse struct {
+	Editions []EditionDetail `json:"editions"`
+}
+
+// EditionDetail is an edition as selected by the EditionDetail fragment,
+// together with its parent book.
+type EditionDetail struct {
+	ID                 int                `json:"id"`
+	Title              string             `json:"title"`
+	Subtitle           string             `json:"subtitle"`
+	ISBN10             string             `json:"isbn_10"`
+	ISBN13             string             `json:"isbn_13"`
+	ASIN               string             `json:"asin"`
+	EditionFormat      string             `json:"edition_format"`
+	EditionInformation string             `json:"edition_information"`
+	PhysicalFormat     string             `json:"physical_format"`
+	Pages              int                `json:"pages"`
+	ReleaseDate        string             `json:"release_date"`
+	AudioSeconds       int                `json:"audio_seconds"`
+	UsersCount         int                `json:"users_count"`
+	Publisher          *PublisherSummary  `json:"publisher"`
+	Language           *EditionLanguage   `json:"language"`
+	ReadingFormat      *ReadingFormatName `json:"reading_format"`
+	Book               *BookSummary       `json:"book"`
+}
+
+// PublisherSummary identifies a publisher.
+type PublisherSummary struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// EditionLanguage is the language an edition is written in.
+type EditionLanguage struct {
+	Language string `json:"language"`
+	Code2    string `json:"code2"`
+	Code3    string `json:"code3"`
+}
+
+// ReadingFormatName is how an edition is read (Physical Book, Audiobook,
+// E-Book).
+type ReadingFormatName struct {
+	Format string `json:"format"`
+}
+
+// BookSummary is the parent book of an edition or shelf entry.
+type BookSummary struct {
+	ID            int                `json:"id"`
+	Title         string             `json:"title"`
+	Slug          string             `json:"slug"`
+	ReleaseYear   int                `json:"release_year"`
+	Rating        float64            `json:"rating"`
+	Pages         int                `json:"pages"`
+	Contributions []BookContribution `json:"contributions"`
+}
+
+// BookDetail is a book as selected by the BookDetail fragment.
+type BookDetail struct {
+	ID                  int                    `json:"id"`
+	Title               string                 `json:"title"`
+	Subtitle            string                 `json:"subtitle"`
+	Slug                string                 `json:"slug"`
+	Description         string                 `json:"description"`
+	Pages               int                    `json:"pages"`
+	ReleaseDate         string                 `json:"release_date"`
+	ReleaseYear         int                    `json:"release_year"`
+	Rating              float64                `json:"rating"`
+	RatingsCount        int                    `json:"ratings_count"`
+	RatingsDistribution []RatingBucket         `json:"ratings_distribution"`
+	ReviewsCount        int                    `json:"reviews_count"`
+	UsersCount          int                    `json:"users_count"`
+	UsersReadCount      int                    `json:"users_read_count"`
+	EditionsCount       int                    `json:"editions_count"`
+	CachedTags          map[string][]CachedTag `json:"cached_tags"`
+	Contributions       []BookContribution     `json:"contributions"`
+	BookSeries          []BookSeriesEntry      `json:"book_series"`
+}
+
+// RatingBucket is one entry of a book's ratings_distribution.
+type RatingBucket struct {
+	Rating float64 `json:"rating"`
+	Count  int     `json:"count"`
+}
+
+// CachedTag is one tag in a book's cached_tags, which groups tags by
+// category (Genre, Mood, Content Warning and Tag).
+type CachedTag struct {
+	Tag          string  `json:"tag"`
+	TagSlug      string  `json:"tagSlug"`
+	Category     string  `json:"category"`
+	CategorySlug string  `json:"categorySlug"`
+	SpoilerRatio float64 `json:"spoilerRatio"`
+	Count        int     `json:"count"`
+}
+
+// BookContribution links a book to one of its authors. Contribution is empty
+// for the primary author and holds the role (e.g. "Translator") otherwise.
+type BookContribution struct {
+	Contribution string         `json:"contribution"`
+	Author       *AuthorSummary `json:"author"`
+}
+
+// Role returns the contributor's role, PrimaryAuthorRole for primary authors.
+func (c *BookContribution) Role() string {
+	if c.Contribution == "" {
+		return PrimaryAuthorRole
+	}
+	return c.Contribution
+}
+
+// AuthorSummary identifies an author.
+type AuthorSummary struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// BookSeriesEntry places a book in a series. Position may be fractional for
+// novellas and is nil for unnumbered entries.
+type BookSeriesEntry struct {
+	Position *float64       `json:"position"`
+	Details  string         `json:"details"`
+	Featured bool           `json:"featured"`
+	Series   *SeriesSummary `json:"series"`
+}
+
+// SeriesSummary identifies a series.
+type SeriesSummary struct {
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	Slug              string `json:"slug"`
+	BooksCount        int    `json:"books_count"`
+	PrimaryBooksCount int    `json:"primary_books_count"`
+}
+
+// TagNames returns the names of the tags in the given cached_tags category,
+// most used first.
+func (b *BookDetail) TagNames(category string) []string {
+	tags := b.CachedTags[category]
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, tag.Tag)
+	}
+	return names
+}
+
+// GetAuthorResponse represents the response from the GetAuthor query.
+type GetAuthorResponse struct {
+	Authors []AuthorDetail `json:"authors"`
+}
+
+// AuthorDetail is an author's profile.
+type AuthorDetail struct {
+	ID             int      `json:"id"`
+	Name           string   `json:"name"`
+	NamePersonal   string   `json:"name_personal"`
+	Slug           string   `json:"slug"`
+	Bio            string   `json:"bio"`
+	BornYear       int      `json:"born_year"`
+	DeathYear      int      `json:"death_year"`
+	BornDate       string   `json:"born_date"`
+	DeathDate      string   `json:"death_date"`
+	AlternateNames []string `json:"alternate_names"`
+	Location       string   `json:"location"`
+	BooksCount     int      `json:"books_count"`
+	UsersCount     int      `json:"users_count"`
+}
+
+// GetContributionsResponse represents the response from the GetContributions
+// query.
+type GetContributionsResponse struct {
+	Contributions []Contribution `json:"contributions"`
+}
+
+// Contribution is an author's contribution to a book. Contribution is empty
+// for the primary author and holds the role (e.g. "Translator") otherwise.
+type Contribution struct {
+	Contribution string         `json:"contribution"`
+	Author       *AuthorSummary `json:"author"`
+	Book         *BookRating    `json:"book"`
+}
+
+// BookRating identifies a book together with its rating and popularity.
+type BookRating struct {
+	ID           int     `json:"id"`
+	Title        string  `json:"title"`
+	Slug         string  `json:"slug"`
+	ReleaseYear  int     `json:"release_year"`
+	Rating       float64 `json:"rating"`
+	RatingsCount int     `json:"ratings_count"`
+	UsersCount   int     `json:"users_count"`
+}
+
+// Role returns the contributor's role, PrimaryAuthorRole for primary authors.
+func (c *Contribution) Role() string {
+	if c.Contribution == "" {
+		return PrimaryAuthorRole
+	}
+	return c.Contribution
+}
+
+// GetSeriesResponse represents the response from the GetSeries query.
+type GetSeriesResponse struct {
+	Series []SeriesDetail `json:"series"`
+}
+
+// SeriesDetail is a series together with its books, in reading order.
+type SeriesDetail struct {
+	ID                int               `json:"id"`
+	Name              string            `json:"name"`
+	Slug              string            `json:"slug"`
+	Description       string            `json:"description"`
+	BooksCount        int               `json:"books_count"`
+	PrimaryBooksCount int               `json:"primary_books_count"`
+	IsCompleted       *bool             `json:"is_completed"`
+	Author            *AuthorSummary    `json:"author"`
+	BookSeries        []SeriesBookEntry `json:"book_series"`
+}
+
+// SeriesBookEntry is a book's place in a series. Position may be fractional
+// for novellas and is nil for unnumbered entries.
+type SeriesBookEntry struct {
+	Position *float64    `json:"position"`
+	Details  string      `json:"details"`
+	Book     *SeriesBook `json:"book"`
+}
+
+// SeriesBook is a book listed in a series.
+type SeriesBook struct {
+	ID          int     `json:"id"`
+	Title       string  `json:"title"`
+	Slug        string  `json:"slug"`
+	ReleaseYear int     `json:"release_year"`
+	Pages       int     `json:"pages"`
+	Rating      float64 `json:"rating"`
+	UsersCount  int     `json:"users_count"`
+	Compilation bool    `json:"compilation"`
+}
+
+// GetCurrentUserIDResponse represents the response from the
+// GetCurrentUserID query. The API returns me as a list holding the current
+// user.
+type GetCurrentUserIDResponse struct {
+	Me []struct {
+		ID int `json:"id"`
+	} `json:"me"`
+}
+
+// GetUserBooksResponse represents the response from the GetUserBooks query.
+type GetUserBooksResponse struct {
+	UserBooks []UserBook `json:"user_books"`
+}
+
+// GetMyUserBooksResponse represents the response from the
+// GetMyUserBooks query. The API returns me as a list holding the current
+// user.
+type GetMyUserBooksResponse struct {
+	Me []struct {
+		UserBooks []UserBook `json:"user_books"`
+	} `json:"me"`
+}
+
+// UserBook is one of the current user's shelf entries, as selected by the
+// UserBook fragment. EditionID and Edition are nil when no edition has been
+// chosen.
+type UserBook struct {
+	ID                int                `json:"id"`
+	BookID            int                `json:"book_id"`
+	EditionID         *int               `json:"edition_id"`
+	StatusID          UserBookStatus     `json:"status_id"`
+	Rating            float64            `json:"rating"`
+	Owned             bool               `json:"owned"`
+	Starred           bool               `json:"starred"`
+	DateAdded         string             `json:"date_added"`
+	PrivacySettingID  PrivacySetting     `json:"privacy_setting_id"`
+	LastReadDate      string             `json:"last_read_date"`
+	ReviewRaw         string             `json:"review_raw"`
+	ReviewHasSpoilers bool               `json:"review_has_spoilers"`
+	ReviewedAt        string             `json:"reviewed_at"`
+	PrivateNotes      string             `json:"private_notes"`
+	ReadingFormatID   ReadingFormat      `json:"reading_format_id"`
+	ReadingFormat     *ReadingFormatName `json:"reading_format"`
+	Book              *BookSummary       `json:"book"`
+	Edition           *UserBookEdition   `json:"edition"`
+	Reads             []UserBookRead     `json:"user_book_reads"`
+}
+
+// UserBookEdition identifies the edition chosen for a shelf entry.
+type UserBookEdition struct {
+	ID             int                `json:"id"`
+	Title          string             `json:"title"`
+	EditionFormat  string             `json:"edition_format"`
+	PhysicalFormat string             `json:"physical_format"`
+	ISBN10         string             `json:"isbn_10"`
+	ISBN13         string             `json:"isbn_13"`
+	Pages          int                `json:"pages"`
+	AudioSeconds   int                `json:"audio_seconds"`
+	ReadingFormat  *ReadingFormatName `json:"reading_format"`
+}
+
+// UserBookRead is one reading of a shelf entry, as selected by the
+// UserBookRead fragment. Dates are YYYY-MM-DD; a read without FinishedAt is
+// in progress, or paused when PausedAt is set.
+type UserBookRead struct {
+	ID              int              `json:"id"`
+	UserBookID      int              `json:"user_book_id"`
+	EditionID       *int             `json:"edition_id"`
+	StartedAt       string           `json:"started_at"`
+	PausedAt        string           `json:"paused_at"`
+	FinishedAt      string           `json:"finished_at"`
+	Progress        float64          `json:"progress"`
+	ProgressPages   int              `json:"progress_pages"`
+	ProgressSeconds int              `json:"progress_seconds"`
+	Edition         *UserBookEdition `json:"edition"`
+}
+
+// UserBookReadResult is the payload of the insert_user_book_read and
+// update_user_book_read mutations. Error is set when the change was
+// rejected.
+type UserBookReadResult struct {
+	ID           int           `json:"id"`
+	Error        string        `json:"error"`
+	UserBookRead *UserBookRead `json:"user_book_read"`
+}
+
+// InsertUserBookReadResponse represents the response from the
+// InsertUserBookRead mutation.
+type InsertUserBookReadResponse struct {
+	InsertUserBookRead *UserBookReadResult `json:"insert_user_book_read"`
+}
+
+// UpdateUserBookReadResponse represents the response from the
+// UpdateUserBookRead mutation.
+type UpdateUserBookReadResponse struct {
+	UpdateUserBookRead *UserBookReadResult `json:"update_user_book_read"`
+}
+
+// UserBookResult is the payload of the insert_user_book and
+// update_user_book mutations. Error is set when the change was rejected.
+type UserBookResult struct {
+	ID       int       `json:"id"`
+	Error    string    `json:"error"`
+	UserBook *UserBook `json:"user_book"`
+}
+
+// InsertUserBookResponse represents the response from the InsertUserBook
+// mutation.
+type InsertUserBookResponse struct {
+	InsertUserBook *UserBookResult `json:"insert_user_book"`
+}
+
+// UpdateUserBookResponse represents the response from the UpdateUserBook
+// mutation.
+type UpdateUserBookResponse struct {
+	UpdateUserBook *UserBookResult `json:"update_user_book"`
+}
+
+// DeleteUserBookResponse represents the response from the DeleteUserBook
+// mutation.
+type DeleteUserBookResponse struct {
+	DeleteUserBook *struct {
+		ID     int `json:"id"`
+		BookID int `json:"book_id"`
+	} `json:"delete_user_book"`
+}
+
+// SearchType is the query_type accepted by the search endpoint.
+type SearchType string
+
+// Query types supported by the search endpoint.
+const (
+	SearchTypeBook      SearchType = "Book"
+	SearchTypeUser      SearchType = "User"
+	SearchTypeAuthor    SearchType = "Author"
+	SearchTypeSeries    SearchType = "Series"
+	SearchTypeList      SearchType = "List"
+	SearchTypeCharacter SearchType = "Character"
+	SearchTypePublisher SearchType = "Publisher"
+	SearchTypePrompt    SearchType = "Prompt"
+)
+
+// SearchDocument is implemented by the document type of each search query
+// type, tying a Go type to the query_type used to search for it.
+type SearchDocument interface {
+	SearchType() SearchType
+}
+
+// SearchResponse represents the response from the Search query.
+type SearchResponse[T SearchDocument] struct {
+	Search *SearchPayload[T] `json:"search"`
+}
+
+// SearchPayload mirrors the SearchOutput type with typed Typesense results.
+type SearchPayload[T SearchDocument] struct {
+	Error     string            `json:"error"`
+	IDs       []json.Number     `json:"ids"`
+	Page      int               `json:"page"`
+	PerPage   int               `json:"per_page"`
+	Query     string            `json:"query"`
+	QueryType string            `json:"query_type"`
+	Results   *SearchResults[T] `json:"results"`
+}
+
+// SearchResults holds the Typesense result set embedded in SearchOutput.results.
+type SearchResults[T SearchDocument] struct {
+	Found        int            `json:"found"`
+	OutOf        int            `json:"out_of"`
+	Page         int            `json:"page"`
+	SearchTimeMS int            `json:"search_time_ms"`
+	Hits         []SearchHit[T] `json:"hits"`
+}
+
+// SearchHit is a single matching document with its highlights.
+type SearchHit[T SearchDocument] struct {
+	Document   T                 `json:"document"`
+	Highlights []SearchHighlight `json:"highlights"`
+	TextMatch  int64             `json:"text_match"`
+}
+
+// SearchHighlight describes where the query matched within a document field.
+type SearchHighlight struct {
+	Field         string          `json:"field"`
+	Snippet       string          `json:"snippet"`
+	Snippets      []string        `json:"snippets"`
+	MatchedTokens json.RawMessage `json:"matched_tokens"`
+}
+
+// SearchImage is the cached image attached to search documents.
+type SearchImage struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Color  string `json:"color"`
+}
+
+// SearchUserSummary is the abbreviated user embedded in list and prompt
+// documents.
+type SearchUserSummary struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+// BookDocument is a book returned by a Book search.
+type BookDocument struct {
+	ID           string       `json:"id"`
+	Title        string       `json:"title"`
+	Subtitle     string       `json:"subtitle"`
+	AuthorNames  []string     `json:"author_names"`
+	ReleaseYear  int          `json:"release_year"`
+	Slug         string       `json:"slug"`
+	Rating       float64      `json:"rating"`
+	RatingsCount int          `json:"ratings_count"`
+	UsersCount   int          `json:"users_count"`
+	Pages        int          `json:"pages"`
+	ISBNs        []string     `json:"isbns"`
+	SeriesNames  []string     `json:"series_names"`
+	Genres       []string     `json:"genres"`
+	Moods        []string     `json:"moods"`
+	HasAudiobook bool         `json:"has_audiobook"`
+	HasEbook     bool         `json:"has_ebook"`
+	Compilation  bool         `json:"compilation"`
+	Description  string       `json:"description"`
+	Image        *SearchImage `json:"image"`
+}
+
+// SearchType implements SearchDocument.
+func (BookDocument) SearchType() SearchType { return SearchTypeBook }
+
+// UserDocument is a user returned by a User search.
+type UserDocument struct {
+	ID                 string       `json:"id"`
+	Username           string       `json:"username"`
+	Name               string       `json:"name"`
+	Location           string       `json:"location"`
+	Flair              string       `json:"flair"`
+	BooksCount         int          `json:"books_count"`
+	FollowersCount     int          `json:"followers_count"`
+	FollowedUsersCount int          `json:"followed_users_count"`
+	Pro                bool         `json:"pro"`
+	Image              *SearchImage `json:"image"`
+}
+
+// SearchType implements SearchDocument.
+func (UserDocument) SearchType() SearchType { return SearchTypeUser }
+
+// AuthorDocument is an author returned by an Author search.
+type AuthorDocument struct {
+	ID             string       `json:"id"`
+	Name           string       `json:"name"`
+	Slug           string       `json:"slug"`
+	NamePersonal   string       `json:"name_personal"`
+	AlternateNames []string     `json:"alternate_names"`
+	BooksCount     int          `json:"books_count"`
+	Books          []string     `json:"books"`
+	SeriesNames    []string     `json:"series_names"`
+	Image          *SearchImage `json:"image"`
+}
+
+// SearchType implements SearchDocument.
+func (AuthorDocument) SearchType() SearchType { return SearchTypeAuthor }
+
+// SeriesDocument is a series returned by a Series search.
+type SeriesDocument struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	Slug              string   `json:"slug"`
+	AuthorName        string   `json:"author_name"`
+	BooksCount        int      `json:"books_count"`
+	PrimaryBooksCount int      `json:"primary_books_count"`
+	ReadersCount      int      `json:"readers_count"`
+	Books             []string `json:"books"`
+}
+
+// SearchType implements SearchDocument.
+func (SeriesDocument) SearchType() SearchType { return SearchTypeSeries }
+
+// ListDocument is a list returned by a List search.
+type ListDocument struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Slug        string             `json:"slug"`
+	Description string             `json:"description"`
+	BooksCount  int                `json:"books_count"`
+	LikesCount  int                `json:"likes_count"`
+	Books       []string           `json:"books"`
+	User        *SearchUserSummary `json:"user"`
+}
+
+// SearchType implements SearchDocument.
+func (ListDocument) SearchType() SearchType { return SearchTypeList }
+
+// CharacterDocument is a character returned by a Character search.
+type CharacterDocument struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Slug        string   `json:"slug"`
+	BooksCount  int      `json:"books_count"`
+	AuthorNames []string `json:"author_names"`
+	Books       []string `json:"books"`
+}
+
+// SearchType implements SearchDocument.
+func (CharacterDocument) SearchType() SearchType { return SearchTypeCharacter }
+
+// PublisherDocument is a publisher returned by a Publisher search.
+type PublisherDocument struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Slug          string `json:"slug"`
+	BooksCount    int    `json:"books_count"`
+	EditionsCount int    `json:"editions_count"`
+}
+
+// SearchType implements SearchDocument.
+func (PublisherDocument) SearchType() SearchType { return SearchTypePublisher }
+
+// PromptDocument is a prompt returned by a Prompt search.
+type PromptDocument struct {
+	ID           string             `json:"id"`
+	Question     string             `json:"question"`
+	Slug         string             `json:"slug"`
+	AnswersCount int                `json:"answers_count"`
+	BooksCount   int                `json:"books_count"`
+	UsersCount   int                `json:"users_count"`
+	Books        []string           `json:"books"`
+	User         *SearchUserSummary `json:"user"`
+}
+
+// SearchType implements SearchDocument.
+func (PromptDocument) SearchType() SearchType { return SearchTypePrompt }
+
+// ReadingJournal is an entry of a user's reading journal, as selected by the
+// ReadingJournal fragment. Event is e.g. "note", "quote" or
+// "progress_updated"; timestamps are as returned by the API.
+type ReadingJournal struct {
+	ID               int                    `json:"id"`
+	BookID           int                    `json:"book_id"`
+	EditionID        *int                   `json:"edition_id"`
+	Event            string                 `json:"event"`
+	Entry            string                 `json:"entry"`
+	Metadata         map[string]interface{} `json:"metadata"`
+	PrivacySettingID PrivacySetting         `json:"privacy_setting_id"`
+	CreatedAt        string                 `json:"created_at"`
+	UpdatedAt        string                 `json:"updated_at"`
+	Book             *BookSummary           `json:"book"`
+}
+
+// GetReadingJournalsResponse represents the response from the
+// GetReadingJournals query.
+type GetReadingJournalsResponse struct {
+	ReadingJournals []ReadingJournal `json:"reading_journals"`
+}
+
+// ReadingJournalSummary is a user's journal entry count for one book.
+type ReadingJournalSummary struct {
+	BookID        int          `json:"book_id"`
+	JournalsCount int          `json:"journals_count"`
+	LastUpdatedAt string       `json:"last_updated_at"`
+	Book          *BookSummary `json:"book"`
+}
+
+// GetReadingJournalsSummaryResponse represents the response from the
+// GetReadingJournalsSummary query.
+type GetReadingJournalsSummaryResponse struct {
+	ReadingJournalsSummary []ReadingJournalSummary `json:"reading_journals_summary"`
+}
+
+// ReadingJournalResult is the payload of the insert_reading_journal and
+// update_reading_journal mutations. Errors is set when the change was
+// rejected.
+type ReadingJournalResult struct {
+	ID             int             `json:"id"`
+	Errors         []string        `json:"errors"`
+	ReadingJournal *ReadingJournal `json:"reading_journal"`
+}
+
+// InsertReadingJournalResponse represents the response from the
+// InsertReadingJournal mutation.
+type InsertReadingJournalResponse struct {
+	InsertReadingJournal *ReadingJournalResult `json:"insert_reading_journal"`
+}
+
+// UpdateReadingJournalResponse represents the response from the
+// UpdateReadingJournal mutation.
+type UpdateReadingJournalResponse struct {
+	UpdateReadingJournal *ReadingJournalResult `json:"update_reading_journal"`
+}
+
+// DeleteReadingJournalResponse represents the response from the
+// DeleteReadingJournal mutation.
+type DeleteReadingJournalResponse struct {
+	DeleteReadingJournal *struct {
+		ID int `json:"id"`
+	} `json:"delete_reading_journal"`
+}
+
+// Goal is one of a user's reading goals, as selected by the Goal fragment:
+// reach Goal units of Metric (e.g. "book" or "pages") between StartDate and
+// EndDate. Progress is maintained by the API.
+type Goal struct {
+	ID               int                    `json:"id"`
+	Metric           string                 `json:"metric"`
+	Goal             int                    `json:"goal"`
+	Progress         float64                `json:"progress"`
+	Description      string                 `json:"description"`
+	StartDate        string                 `json:"start_date"`
+	EndDate          string                 `json:"end_date"`
+	CompletedAt      string                 `json:"completed_at"`
+	State            string                 `json:"state"`
+	Archived         bool                   `json:"archived"`
+	Conditions       map[string]interface{} `json:"conditions"`
+	PrivacySettingID PrivacySetting         `json:"privacy_setting_id"`
+}
+
+// GetGoalsResponse represents the response from the GetGoals query.
+type GetGoalsResponse struct {
+	Goals []Goal `json:"goals"`
+}
+
+// GoalResult is the payload of the insert_goal, update_goal and
+// update_goal_progress mutations. Errors is set when the change was
+// rejected.
+type GoalResult struct {
+	ID     int      `json:"id"`
+	Errors []string `json:"errors"`
+	Goal   *Goal    `json:"goal"`
+}
+
+// InsertGoalResponse represents the response from the InsertGoal mutation.
+type InsertGoalResponse struct {
+	InsertGoal *GoalResult `json:"insert_goal"`
+}
+
+// UpdateGoalResponse represents the response from the UpdateGoal mutation.
+type UpdateGoalResponse struct {
+	UpdateGoal *GoalResult `json:"update_goal"`
+}
+
+// UpdateGoalProgressResponse represents the response from the
+// UpdateGoalProgress mutation.
+type UpdateGoalProgressResponse struct {
+	UpdateGoalProgress *GoalResult `json:"update_goal_progress"`
+}
+
+// List is a user's book list, as selected by the List fragment. Ranked lists
+// order their books by position. ListBooks is only selected by GetList.
+type List struct {
+	ID               int            `json:"id"`
+	Name             string         `json:"name"`
+	Slug             string         `json:"slug"`
+	Description      string         `json:"description"`
+	Ranked           bool           `json:"ranked"`
+	Public           bool           `json:"public"`
+	PrivacySettingID PrivacySetting `json:"privacy_setting_id"`
+	BooksCount       int            `json:"books_count"`
+	UserID           int            `json:"user_id"`
+	User             *struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	ListBooks []ListBook `json:"list_books"`
+}
+
+// ListBook is a book on a list, as selected by the ListBook fragment.
+// Position is zero when the book has none.
+type ListBook struct {
+	ID        int          `json:"id"`
+	ListID    int          `json:"list_id"`
+	BookID    int          `json:"book_id"`
+	EditionID *int         `json:"edition_id"`
+	Position  int          `json:"position"`
+	Reason    string       `json:"reason"`
+	DateAdded string       `json:"date_added"`
+	Book      *BookSummary `json:"book"`
+}
+
+// GetListResponse represents the response from the GetList query.
+type GetListResponse struct {
+	Lists []List `json:"lists"`
+}
+
+// GetListsResponse represents the response from the GetLists query.
+type GetListsResponse struct {
+	Lists []List `json:"lists"`
+}
+
+// ListResult is the payload of the insert_list and update_list mutations.
+// Errors is set when the change was rejected.
+type ListResult struct {
+	ID     int      `json:"id"`
+	Errors []string `json:"errors"`
+	List   *List    `json:"list"`
+}
+
+// InsertListResponse represents the response from the InsertList mutation.
+type InsertListResponse struct {
+	InsertList *ListResult `json:"insert_list"`
+}
+
+// UpdateListResponse represents the response from the UpdateList mutation.
+type UpdateListResponse struct {
+	UpdateList *ListResult `json:"update_list"`
+}
+
+// DeleteListResponse represents the response from the DeleteList mutation.
+type DeleteListResponse struct {
+	DeleteList *struct {
+		Success bool `json:"success"`
+	} `json:"delete_list"`
+}
+
+// InsertListBookResponse represents the response from the InsertListBook
+// mutation.
+type InsertListBookResponse struct {
+	InsertListBook *struct {
+		ID       int       `json:"id"`
+		ListBook *ListBook `json:"list_book"`
+	} `json:"insert_list_book"`
+}
+
+// DeleteListBookResponse represents the response from the DeleteListBook
+// mutation.
+type DeleteListBookResponse struct {
+	DeleteListBook *struct {
+		ID     int `json:"id"`
+		ListID int `json:"list_id"`
+	} `json:"delete_list_book"`
+}
+
+// UpdateListBookPositionResponse represents the response from the
+// UpdateListBookPosition mutation.
+type UpdateListBookPositionResponse struct {
+	UpdateListBooks *struct {
+		AffectedRows int `json:"affected_rows"`
+	} `json:"update_list_books"`
 }
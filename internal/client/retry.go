@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxBackoffShift caps the doubling of the backoff so it cannot overflow.
+const maxBackoffShift = 30
+
+// RetryPolicy controls how Execute retries failed requests. Queries are
+// retried after network errors, 429 Too Many Requests and 5xx responses;
+// mutations only when their context is marked with WithRetrySafe. The zero
+// policy never retries.
+type RetryPolicy struct {
+	// MaxRetries is the number of attempts made after the first fails.
+	MaxRetries int
+	// BaseDelay is the wait before the first retry. It doubles for each
+	// further retry, with jitter.
+	BaseDelay time.Duration
+	// MaxDelay caps the wait between attempts. A server asking for a
+	// longer wait with Retry-After is not retried. Zero means no cap.
+	MaxDelay time.Duration
+}
+
+// WithRetryPolicy sets the client's retry policy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// retrySafeKey is the context key marking a mutation as safe to retry.
+type retrySafeKey struct{}
+
+// WithRetrySafe marks the requests made with ctx as safe to retry, for
+// mutations that can be repeated without changing the outcome, such as
+// updates that set fields to fixed values.
+func WithRetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, true)
+}
+
+// retryable reports whether a failed request for query may be retried.
+func retryable(ctx context.Context, query string) bool {
+	if !strings.HasPrefix(strings.TrimSpace(query), "mutation") {
+		return true
+	}
+	safe, _ := ctx.Value(retrySafeKey{}).(bool)
+	return safe
+}
+
+// retryHint describes a failed attempt that may be retried.
+type retryHint struct {
+	// after is the wait the server asked for, or zero.
+	after time.Duration
+}
+
+// retryHintFor returns the hint for a response status, or nil when the
+// status is not worth retrying.
+func retryHintFor(resp *http.Response) *retryHint {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < http.StatusInternalServerError {
+		return nil
+	}
+	return &retryHint{after: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())}
+}
+
+// networkRetryHint returns the hint for a network error: worth retrying
+// unless the caller has given up on the request.
+func networkRetryHint(ctx context.Context) *retryHint {
+	if ctx.Err() != nil {
+		return nil
+	}
+	return &retryHint{}
+}
+
+// parseRetryAfter reads a Retry-After header, given in seconds or as an
+// HTTP date. It returns zero when the header is missing or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
+	}
+	return 0
+}
+
+// delay returns the wait before retry number attempt, counting from zero,
+// and false when no further attempt should be made.
+func (p RetryPolicy) delay(attempt int, hint *retryHint) (time.Duration, bool) {
+	if attempt >= p.MaxRetries {
+		return 0, false
+	}
+	if hint.after > 0 {
+		return hint.after, p.MaxDelay == 0 || hint.after <= p.MaxDelay
+	}
+
+	backoff := p.BaseDelay << min(attempt, maxBackoffShift)
+	if p.MaxDelay > 0 && (backoff > p.MaxDelay || backoff <= 0) {
+		backoff = p.MaxDelay
+	}
+	if backoff <= 1 {
+		return backoff, true
+	}
+	// Waiting between half and all of the backoff spreads out clients that
+	// failed together.
+	half := backoff / 2
+	return half + time.Duration(rand.Int64N(int64(backoff-half))), true //nolint:gosec // jitter needs no secure randomness
+}
+
+// sleep waits for d, returning early with the context's error when it is
+// done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
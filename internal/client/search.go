@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// DefaultSearchPerPage is the number of results requested per page when no
+// page size is given.
+const DefaultSearchPerPage = 25
+
+// SearchOptions controls paging, sorting and field selection of a search
+// request. Sort, Fields and Weights use the comma-separated syntax of the
+// search endpoint, e.g. Sort "users_count:desc" or Fields "title,author_names".
+type SearchOptions struct {
+	Page    int
+	PerPage int
+	Sort    string
+	Fields  string
+	Weights string
+}
+
+// variables converts the options into GraphQL variables, applying defaults
+// for unset values.
+func (o *SearchOptions) variables() map[string]interface{} {
+	page, perPage := 1, DefaultSearchPerPage
+	if o != nil {
+		if o.Page > 0 {
+			page = o.Page
+		}
+		if o.PerPage > 0 {
+			perPage = o.PerPage
+		}
+	}
+	variables := map[string]interface{}{
+		"page":     page,
+		"per_page": perPage,
+	}
+	if o != nil {
+		if o.Sort != "" {
+			variables["sort"] = strings.Join(SplitList(o.Sort), ",")
+		}
+		if o.Fields != "" {
+			variables["fields"] = strings.Join(SplitList(o.Fields), ",")
+		}
+		if o.Weights != "" {
+			variables["weights"] = strings.Join(SplitList(o.Weights), ",")
+		}
+	}
+	return variables
+}
+
+// Search executes the Search query for the query type of T and returns the
+// typed Typesense results.
+func Search[T SearchDocument](
+	ctx context.Context,
+	c *Client,
+	query string,
+	opts *SearchOptions,
+) (*SearchResults[T], error) {
+	var doc T
+	if err := opts.Validate(doc.SearchType()); err != nil {
+		return nil, err
+	}
+
+	variables := opts.variables()
+	variables["query"] = query
+	variables["query_type"] = string(doc.SearchType())
+
+	var response SearchResponse[T]
+	if err := c.Execute(ctx, SearchQuery, variables, &response); err != nil {
+		return nil, err
+	}
+
+	if response.Search == nil {
+		return &SearchResults[T]{}, nil
+	}
+	if response.Search.Error != "" {
+		return nil, fmt.Errorf("search failed: %s", response.Search.Error)
+	}
+	if response.Search.Results == nil {
+		return &SearchResults[T]{Page: response.Search.Page}, nil
+	}
+	return response.Search.Results, nil
+}
+
+// Documents returns the documents of all hits in order.
+func (r *SearchResults[T]) Documents() []T {
+	docs := make([]T, 0, len(r.Hits))
+	for i := range r.Hits {
+		docs = append(docs, r.Hits[i].Document)
+	}
+	return docs
+}
+
+// SearchPages returns an iterator that lazily fetches successive pages of
+// results starting at opts.Page. Iteration stops once every one of the found
+// results has been returned, a page comes back empty, or a request fails, in
+// which case the error is yielded with a nil page.
+func SearchPages[T SearchDocument](
+	ctx context.Context,
+	c *Client,
+	query string,
+	opts *SearchOptions,
+) iter.Seq2[*SearchResults[T], error] {
+	return func(yield func(*SearchResults[T], error) bool) {
+		pageOpts := SearchOptions{Page: 1, PerPage: DefaultSearchPerPage}
+		if opts != nil {
+			pageOpts = *opts
+			if pageOpts.Page < 1 {
+				pageOpts.Page = 1
+			}
+			if pageOpts.PerPage < 1 {
+				pageOpts.PerPage = DefaultSearchPerPage
+			}
+		}
+
+		for {
+			results, err := Search[T](ctx, c, query, &pageOpts)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(results, nil) {
+				return
+			}
+			if len(results.Hits) == 0 || pageOpts.Page*pageOpts.PerPage >= results.Found {
+				return
+			}
+			pageOpts.Page++
+		}
+	}
+}
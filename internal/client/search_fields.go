@@ -0,0 +1,204 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// textMatchSort is the pseudo-field Typesense uses to sort by relevance.
+const textMatchSort = "_text_match"
+
+// maxSuggestionDistance is the largest edit distance at which an unknown
+// field name is considered a typo of a known one.
+const maxSuggestionDistance = 3
+
+// searchTypeFields describes the document fields of a query type that can be
+// searched or sorted on, and the fields searched when none are given.
+type searchTypeFields struct {
+	available []string
+	defaults  []string
+}
+
+// searchFields holds the per-type allow-lists from the Hardcover searching
+// guide (https://docs.hardcover.app/api/guides/searching/).
+var searchFields = map[SearchType]searchTypeFields{
+	SearchTypeAuthor: {
+		available: []string{
+			"alternate_names", "books", "books_count", "image", "name", "name_personal", "series_names", "slug",
+		},
+		defaults: []string{"name", "name_personal", "alternate_names", "series_names", "books"},
+	},
+	SearchTypeBook: {
+		available: []string{
+			"activities_count", "alternative_titles", "audio_seconds", "author_names", "compilation",
+			"content_warnings", "contribution_types", "contributions", "cover_color", "description",
+			"featured_series", "featured_series_position", "genres", "isbns", "lists_count", "has_audiobook",
+			"has_ebook", "moods", "pages", "prompts_count", "rating", "ratings_count", "release_date_i",
+			"release_year", "reviews_count", "series_names", "slug", "subtitle", "tags", "title",
+			"users_count", "users_read_count",
+		},
+		defaults: []string{"title", "isbns", "series_names", "author_names", "alternative_titles"},
+	},
+	SearchTypeCharacter: {
+		available: []string{"author_names", "books", "books_count", "name", "object_type", "slug"},
+		defaults:  []string{"name", "books", "author_names"},
+	},
+	SearchTypeList: {
+		available: []string{"description", "books", "books_count", "likes_count", "object_type", "name", "slug", "user"},
+		defaults:  []string{"name", "description", "books"},
+	},
+	SearchTypePrompt: {
+		available: []string{"answers_count", "books", "books_count", "question", "slug", "user", "users_count"},
+		defaults:  []string{"question", "books"},
+	},
+	SearchTypePublisher: {
+		available: []string{"editions_count", "name", "object_type", "slug"},
+		defaults:  []string{"name"},
+	},
+	SearchTypeSeries: {
+		available: []string{
+			"author_name", "author", "books_count", "books", "name", "primary_books_count", "readers_count", "slug",
+		},
+		defaults: []string{"name", "books", "author_name"},
+	},
+	SearchTypeUser: {
+		available: []string{
+			"books_count", "flair", "followed_users_count", "followers_count", "image", "location", "name", "pro",
+			"username",
+		},
+		defaults: []string{"name", "username", "location"},
+	},
+}
+
+// SearchFields returns the fields that can be used with the sort and fields
+// options for the given query type, in sorted order.
+func SearchFields(queryType SearchType) []string {
+	fields := append([]string{}, searchFields[queryType].available...)
+	sort.Strings(fields)
+	return fields
+}
+
+// Validate checks the sort, fields and weights options against the allow-list
+// of the given query type so that typos are reported before the request is
+// sent.
+func (o *SearchOptions) Validate(queryType SearchType) error {
+	if o == nil {
+		return nil
+	}
+
+	spec, ok := searchFields[queryType]
+	if !ok {
+		return fmt.Errorf("unknown search type %q", queryType)
+	}
+
+	if err := validateSort(queryType, spec, o.Sort); err != nil {
+		return err
+	}
+
+	fields := spec.defaults
+	if o.Fields != "" {
+		fields = SplitList(o.Fields)
+		for _, field := range fields {
+			if err := checkField(queryType, spec, "field", field); err != nil {
+				return err
+			}
+		}
+	}
+
+	return validateWeights(o.Weights, fields)
+}
+
+// validateSort checks a Typesense sort expression such as
+// "_text_match:desc,users_count:desc".
+func validateSort(queryType SearchType, spec searchTypeFields, sortBy string) error {
+	if sortBy == "" {
+		return nil
+	}
+
+	for _, clause := range SplitList(sortBy) {
+		field, direction, hasDirection := strings.Cut(clause, ":")
+		if hasDirection && direction != "asc" && direction != "desc" {
+			return fmt.Errorf("invalid sort direction %q in %q: use asc or desc", direction, clause)
+		}
+		if field == textMatchSort {
+			continue
+		}
+		if err := checkField(queryType, spec, "sort field", field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateWeights checks that weights are non-negative integers with one
+// weight per searched field.
+func validateWeights(weights string, fields []string) error {
+	if weights == "" {
+		return nil
+	}
+
+	values := SplitList(weights)
+	for _, value := range values {
+		weight, err := strconv.Atoi(value)
+		if err != nil || weight < 0 {
+			return fmt.Errorf("invalid weight %q: weights must be non-negative integers", value)
+		}
+	}
+	if len(values) != len(fields) {
+		return fmt.Errorf("got %d weights for %d fields (%s): give one weight per field",
+			len(values), len(fields), strings.Join(fields, ","))
+	}
+	return nil
+}
+
+// checkField returns an error naming the closest known field when field is
+// not in the allow-list for the query type.
+func checkField(queryType SearchType, spec searchTypeFields, kind, field string) error {
+	for _, known := range spec.available {
+		if field == known {
+			return nil
+		}
+	}
+
+	msg := fmt.Sprintf("unknown %s %q for %s search", kind, field, strings.ToLower(string(queryType)))
+	if suggestion := closestField(field, spec.available); suggestion != "" {
+		msg += fmt.Sprintf("; did you mean %q?", suggestion)
+	}
+	return fmt.Errorf("%s (available: %s)", msg, strings.Join(SearchFields(queryType), ", "))
+}
+
+// closestField returns the candidate closest to field by edit distance, or ""
+// when none is close enough to be a likely typo.
+func closestField(field string, candidates []string) string {
+	best, bestDistance := "", maxSuggestionDistance+1
+	for _, candidate := range candidates {
+		if d := editDistance(field, candidate); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// editDistance computes the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
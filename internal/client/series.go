@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// GetSeries fetches a series and its books by Hardcover ID. It returns
+// ErrNotFound when no series has that ID.
+func (c *Client) GetSeries(ctx context.Context, id int) (*SeriesDetail, error) {
+	return c.getSeriesWhere(ctx, map[string]interface{}{"id": eq(id)})
+}
+
+// GetSeriesBySlug fetches a series and its books by URL slug. It returns
+// ErrNotFound when no series has that slug.
+func (c *Client) GetSeriesBySlug(ctx context.Context, slug string) (*SeriesDetail, error) {
+	return c.getSeriesWhere(ctx, map[string]interface{}{"slug": eq(slug)})
+}
+
+// getSeriesWhere executes the GetSeries query with a series_bool_exp filter.
+func (c *Client) getSeriesWhere(ctx context.Context, where map[string]interface{}) (*SeriesDetail, error) {
+	variables := map[string]interface{}{
+		"where": where,
+	}
+	var response GetSeriesResponse
+	if err := c.Execute(ctx, GetSeriesQuery, variables, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Series) == 0 {
+		return nil, fmt.Errorf("no matching series: %w", ErrNotFound)
+	}
+	return &response.Series[0], nil
+}
+
+// BookIDs returns the IDs of the books in the series, in reading order.
+func (s *SeriesDetail) BookIDs() []int {
+	ids := make([]int, 0, len(s.BookSeries))
+	for _, entry := range s.BookSeries {
+		if entry.Book != nil {
+			ids = append(ids, entry.Book.ID)
+		}
+	}
+	return ids
+}
+
+// IsPrimary reports whether the entry is one of the series' main books: a
+// whole-numbered position that is not a compilation. Novellas sit at
+// fractional positions between the main books.
+func (e *SeriesBookEntry) IsPrimary() bool {
+	if e.Position == nil || e.Book == nil || e.Book.Compilation {
+		return false
+	}
+	return *e.Position == math.Trunc(*e.Position)
+}
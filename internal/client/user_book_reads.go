@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"errors"
+)
+
+// UserBookReadInput is the complete state of a read to store. Unlike
+// UserBookInput, nil fields are sent as null and clear the stored value, so
+// updates start from UserBookRead.Input.
+type UserBookReadInput struct {
+	EditionID       *int    `json:"edition_id"`
+	StartedAt       *string `json:"started_at"`
+	PausedAt        *string `json:"paused_at"`
+	FinishedAt      *string `json:"finished_at"`
+	ProgressPages   *int    `json:"progress_pages"`
+	ProgressSeconds *int    `json:"progress_seconds"`
+}
+
+// Input returns the read's current state as an input to modify.
+func (r *UserBookRead) Input() *UserBookReadInput {
+	input := &UserBookReadInput{EditionID: r.EditionID}
+	for _, field := range []struct {
+		value string
+		dest  **string
+	}{
+		{r.StartedAt, &input.StartedAt},
+		{r.PausedAt, &input.PausedAt},
+		{r.FinishedAt, &input.FinishedAt},
+	} {
+		if field.value != "" {
+			value := field.value
+			*field.dest = &value
+		}
+	}
+	if r.ProgressPages > 0 {
+		pages := r.ProgressPages
+		input.ProgressPages = &pages
+	}
+	if r.ProgressSeconds > 0 {
+		seconds := r.ProgressSeconds
+		input.ProgressSeconds = &seconds
+	}
+	return input
+}
+
+// ActiveRead returns the entry's latest unfinished read, which may be
+// paused, or nil when every read is finished.
+func (u *UserBook) ActiveRead() *UserBookRead {
+	for i := len(u.Reads) - 1; i >= 0; i-- {
+		if u.Reads[i].FinishedAt == "" {
+			return &u.Reads[i]
+		}
+	}
+	return nil
+}
+
+// Length returns the number of pages and the audio length in seconds of the
+// book as read, preferring the read's edition, then the entry's edition. The
+// book's page count is only used when neither edition has a length, so that
+// audiobooks are measured in seconds. Either is zero when unknown; read may
+// be nil.
+func (u *UserBook) Length(read *UserBookRead) (int, int) {
+	var pages, seconds int
+	editions := []*UserBookEdition{u.Edition}
+	if read != nil {
+		editions = []*UserBookEdition{read.Edition, u.Edition}
+	}
+	for _, edition := range editions {
+		if edition == nil {
+			continue
+		}
+		if pages == 0 {
+			pages = edition.Pages
+		}
+		if seconds == 0 {
+			seconds = edition.AudioSeconds
+		}
+	}
+	if pages == 0 && seconds == 0 && u.Book != nil {
+		pages = u.Book.Pages
+	}
+	return pages, seconds
+}
+
+// AddUserBookRead records a new read of one of the current user's shelf
+// entries.
+func (c *Client) AddUserBookRead(ctx context.Context, userBookID int, input *UserBookReadInput) (*UserBookRead, error) {
+	variables := map[string]interface{}{
+		"user_book_id":   userBookID,
+		"user_book_read": input,
+	}
+	var response InsertUserBookReadResponse
+	if err := c.Execute(ctx, InsertUserBookReadMutation, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.InsertUserBookRead.userBookRead()
+}
+
+// UpdateUserBookRead replaces the state of one of the current user's reads.
+func (c *Client) UpdateUserBookRead(ctx context.Context, id int, input *UserBookReadInput) (*UserBookRead, error) {
+	variables := map[string]interface{}{
+		"id":     id,
+		"object": input,
+	}
+	var response UpdateUserBookReadResponse
+	if err := c.Execute(WithRetrySafe(ctx), UpdateUserBookReadMutation, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.UpdateUserBookRead.userBookRead()
+}
+
+// userBookRead returns the read of a mutation result, or the error the API
+// reported for it.
+func (r *UserBookReadResult) userBookRead() (*UserBookRead, error) {
+	switch {
+	case r == nil:
+		return nil, errors.New("empty response from the API")
+	case r.Error != "":
+		return nil, errors.New(r.Error)
+	case r.UserBookRead == nil:
+		return &UserBookRead{ID: r.ID}, nil
+	default:
+		return r.UserBookRead, nil
+	}
+}
@@ -0,0 +1,423 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// UserBookStatus is the id of a user_book_statuses row: where a book sits on
+// the user's shelves.
+type UserBookStatus int
+
+// Reading statuses defined by user_book_statuses.
+const (
+	StatusWantToRead       UserBookStatus = 1
+	StatusCurrentlyReading UserBookStatus = 2
+	StatusRead             UserBookStatus = 3
+	StatusPaused           UserBookStatus = 4
+	StatusDidNotFinish     UserBookStatus = 5
+	StatusIgnored          UserBookStatus = 6
+)
+
+// userBookStatusInfo describes a status: its display name, its slug and the
+// other names ParseUserBookStatus accepts for it.
+type userBookStatusInfo struct {
+	name    string
+	slug    string
+	aliases []string
+}
+
+// userBookStatuses holds the known statuses in id order.
+var userBookStatuses = []struct {
+	status UserBookStatus
+	info   userBookStatusInfo
+}{
+	{StatusWantToRead, userBookStatusInfo{"Want to Read", "want-to-read", []string{"want", "wtr", "tbr"}}},
+	{StatusCurrentlyReading, userBookStatusInfo{"Currently Reading", "currently-reading", []string{"reading", "current"}}},
+	{StatusRead, userBookStatusInfo{"Read", "read", []string{"finished"}}},
+	{StatusPaused, userBookStatusInfo{"Paused", "paused", []string{"pause"}}},
+	{StatusDidNotFinish, userBookStatusInfo{"Did Not Finish", "did-not-finish", []string{"dnf", "abandoned"}}},
+	{StatusIgnored, userBookStatusInfo{"Ignored", "ignored", []string{"ignore"}}},
+}
+
+// lookupStatus returns the description of a known status.
+func lookupStatus(s UserBookStatus) (userBookStatusInfo, bool) {
+	for _, entry := range userBookStatuses {
+		if entry.status == s {
+			return entry.info, true
+		}
+	}
+	return userBookStatusInfo{}, false
+}
+
+// String returns the status's display name, e.g. "Want to Read".
+func (s UserBookStatus) String() string {
+	if info, ok := lookupStatus(s); ok {
+		return info.name
+	}
+	return "Status " + strconv.Itoa(int(s))
+}
+
+// Slug returns the status's slug, e.g. "want-to-read".
+func (s UserBookStatus) Slug() string {
+	if info, ok := lookupStatus(s); ok {
+		return info.slug
+	}
+	return strconv.Itoa(int(s))
+}
+
+// UserBookStatusSlugs lists the slugs of the known statuses in id order.
+func UserBookStatusSlugs() []string {
+	slugs := make([]string, 0, len(userBookStatuses))
+	for _, entry := range userBookStatuses {
+		slugs = append(slugs, entry.info.slug)
+	}
+	return slugs
+}
+
+// ParseUserBookStatus parses a status given by id, slug, display name or a
+// common alias such as "dnf" or "reading". Matching ignores case, spaces,
+// hyphens and underscores.
+func ParseUserBookStatus(value string) (UserBookStatus, error) {
+	if id, err := strconv.Atoi(value); err == nil {
+		if _, ok := lookupStatus(UserBookStatus(id)); ok {
+			return UserBookStatus(id), nil
+		}
+	}
+
+	key := normalizeStatusName(value)
+	for _, entry := range userBookStatuses {
+		names := append([]string{entry.info.name, entry.info.slug}, entry.info.aliases...)
+		for _, name := range names {
+			if normalizeStatusName(name) == key {
+				return entry.status, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unknown status %q (available: %s)", value, strings.Join(UserBookStatusSlugs(), ", "))
+}
+
+// normalizeStatusName lowercases a status name and drops separators.
+func normalizeStatusName(name string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(name))
+}
+
+// ReadingFormat is the id of a reading_formats row: how the user reads a
+// book.
+type ReadingFormat int
+
+// Reading formats defined by reading_formats.
+const (
+	ReadingFormatPhysical  ReadingFormat = 1
+	ReadingFormatAudiobook ReadingFormat = 2
+	ReadingFormatBoth      ReadingFormat = 3
+	ReadingFormatEbook     ReadingFormat = 4
+)
+
+// UserBookInput holds the fields of a shelf entry to set. Zero and nil
+// fields are left unchanged; BookID is only used when adding a book. Set
+// ClearRating to remove the rating, which a nil Rating cannot express.
+type UserBookInput struct {
+	BookID            int            `json:"book_id,omitempty"`
+	StatusID          UserBookStatus `json:"status_id,omitempty"`
+	EditionID         *int           `json:"edition_id,omitempty"`
+	Rating            *float64       `json:"rating,omitempty"`
+	ClearRating       bool           `json:"-"`
+	ReviewRaw         *string        `json:"review_raw,omitempty"`
+	ReviewHasSpoilers *bool          `json:"review_has_spoilers,omitempty"`
+	ReviewedAt        *string        `json:"reviewed_at,omitempty"`
+	PrivateNotes      *string        `json:"private_notes,omitempty"`
+	DateAdded         *string        `json:"date_added,omitempty"`
+	Owned             *bool          `json:"owned,omitempty"`
+	ReadingFormatID   ReadingFormat  `json:"reading_format_id,omitempty"`
+	PrivacySettingID  PrivacySetting `json:"privacy_setting_id,omitempty"`
+}
+
+// MarshalJSON encodes the input, sending a null rating when ClearRating is
+// set.
+func (in UserBookInput) MarshalJSON() ([]byte, error) {
+	type plain UserBookInput
+	data, err := json.Marshal(plain(in))
+	if err != nil || !in.ClearRating {
+		return data, err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	fields["rating"] = nil
+	return json.Marshal(fields)
+}
+
+// IsEmpty reports whether the input changes nothing.
+func (in *UserBookInput) IsEmpty() bool {
+	return *in == UserBookInput{BookID: in.BookID}
+}
+
+// GetMyUserBooks fetches the current user's shelf entries for the given
+// books, keyed by book ID. Books the user has not shelved are absent.
+func (c *Client) GetMyUserBooks(ctx context.Context, bookIDs []int) (map[int]UserBook, error) {
+	userBooks := make(map[int]UserBook)
+	if len(bookIDs) == 0 {
+		return userBooks, nil
+	}
+
+	variables := map[string]interface{}{
+		"book_ids": bookIDs,
+	}
+	var response GetMyUserBooksResponse
+	if err := c.Execute(ctx, GetMyUserBooksQuery, variables, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Me) == 0 {
+		return nil, fmt.Errorf("no current user: %w", ErrUnauthorized)
+	}
+	for _, entry := range response.Me[0].UserBooks {
+		userBooks[entry.BookID] = entry
+	}
+	return userBooks, nil
+}
+
+// GetMyUserBook fetches the current user's shelf entry for a book. It
+// returns ErrNotFound when the book is not on the user's shelves.
+func (c *Client) GetMyUserBook(ctx context.Context, bookID int) (*UserBook, error) {
+	userBooks, err := c.GetMyUserBooks(ctx, []int{bookID})
+	if err != nil {
+		return nil, err
+	}
+	userBook, ok := userBooks[bookID]
+	if !ok {
+		return nil, fmt.Errorf("book %d is not on your shelves: %w", bookID, ErrNotFound)
+	}
+	return &userBook, nil
+}
+
+// AddUserBook adds a book to the current user's shelves.
+func (c *Client) AddUserBook(ctx context.Context, input *UserBookInput) (*UserBook, error) {
+	if input.BookID == 0 {
+		return nil, errors.New("a book ID is required")
+	}
+
+	variables := map[string]interface{}{
+		"object": input,
+	}
+	var response InsertUserBookResponse
+	if err := c.Execute(ctx, InsertUserBookMutation, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.InsertUserBook.userBook()
+}
+
+// UpdateUserBook changes one of the current user's shelf entries.
+func (c *Client) UpdateUserBook(ctx context.Context, id int, input *UserBookInput) (*UserBook, error) {
+	variables := map[string]interface{}{
+		"id":     id,
+		"object": input,
+	}
+	var response UpdateUserBookResponse
+	if err := c.Execute(WithRetrySafe(ctx), UpdateUserBookMutation, variables, &response); err != nil {
+		return nil, err
+	}
+	return response.UpdateUserBook.userBook()
+}
+
+// DeleteUserBook removes one of the current user's shelf entries.
+func (c *Client) DeleteUserBook(ctx context.Context, id int) error {
+	variables := map[string]interface{}{
+		"id": id,
+	}
+	var response DeleteUserBookResponse
+	if err := c.Execute(ctx, DeleteUserBookMutation, variables, &response); err != nil {
+		return err
+	}
+	if response.DeleteUserBook == nil {
+		return fmt.Errorf("shelf entry %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+// userBook returns the shelf entry of a mutation result, or the error the
+// API reported for it.
+func (r *UserBookResult) userBook() (*UserBook, error) {
+	switch {
+	case r == nil:
+		return nil, errors.New("empty response from the API")
+	case r.Error != "":
+		return nil, errors.New(r.Error)
+	case r.UserBook == nil:
+		return &UserBook{ID: r.ID}, nil
+	default:
+		return r.UserBook, nil
+	}
+}
+
+// UserBookSortFields lists the fields GetUserBooks can order by.
+var UserBookSortFields = []string{"date_added", "rating", "title"}
+
+// UserBookFilter selects the shelf entries returned by GetUserBooks. Zero
+// values and nil pointers leave a criterion out. Ratings and dates are
+// inclusive bounds; dates are YYYY-MM-DD. ReadingFormat matches a substring
+// of the format name and Tag the name or slug of one of the user's tags.
+type UserBookFilter struct {
+	UserID        int
+	Statuses      []UserBookStatus
+	MinRating     float64
+	MaxRating     float64
+	Owned         *bool
+	Starred       *bool
+	AddedFrom     string
+	AddedTo       string
+	ReadingFormat string
+	Tag           string
+	OrderBy       string
+	Descending    bool
+}
+
+// where builds the user_books_bool_exp for the filter.
+func (f *UserBookFilter) where() (map[string]interface{}, error) {
+	if f.UserID == 0 {
+		return nil, errors.New("a user ID is required")
+	}
+	conditions := []interface{}{
+		map[string]interface{}{"user_id": eq(f.UserID)},
+	}
+
+	if len(f.Statuses) > 0 {
+		conditions = append(conditions, map[string]interface{}{"status_id": map[string]interface{}{"_in": f.Statuses}})
+	}
+	if bounds := rangeComparison(f.MinRating, f.MaxRating); bounds != nil {
+		conditions = append(conditions, map[string]interface{}{"rating": bounds})
+	}
+	if bounds := rangeComparison(f.AddedFrom, f.AddedTo); bounds != nil {
+		conditions = append(conditions, map[string]interface{}{"date_added": bounds})
+	}
+	if f.Owned != nil {
+		conditions = append(conditions, map[string]interface{}{"owned": eq(*f.Owned)})
+	}
+	if f.Starred != nil {
+		conditions = append(conditions, map[string]interface{}{"starred": eq(*f.Starred)})
+	}
+	if f.ReadingFormat != "" {
+		conditions = append(conditions, map[string]interface{}{
+			"reading_format": map[string]interface{}{"format": ilike(f.ReadingFormat)},
+		})
+	}
+	if f.Tag != "" {
+		conditions = append(conditions, map[string]interface{}{"book": map[string]interface{}{
+			"taggings": map[string]interface{}{
+				"user_id": eq(f.UserID),
+				"tag": map[string]interface{}{"_or": []interface{}{
+					map[string]interface{}{"tag": iequal(f.Tag)},
+					map[string]interface{}{"slug": eq(strings.ToLower(f.Tag))},
+				}},
+			},
+		}})
+	}
+
+	return map[string]interface{}{"_and": conditions}, nil
+}
+
+// rangeComparison builds a _gte/_lte comparison from inclusive bounds,
+// leaving out zero bounds. It returns nil when both bounds are zero.
+func rangeComparison[T comparable](low, high T) map[string]interface{} {
+	var zero T
+	comparison := make(map[string]interface{})
+	if low != zero {
+		comparison["_gte"] = low
+	}
+	if high != zero {
+		comparison["_lte"] = high
+	}
+	if len(comparison) == 0 {
+		return nil
+	}
+	return comparison
+}
+
+// orderBy builds the user_books_order_by list for the filter. Entries are
+// ordered by title through their book, and ties are broken by entry ID so
+// that pages do not overlap.
+func (f *UserBookFilter) orderBy() ([]interface{}, error) {
+	field := f.OrderBy
+	if field == "" {
+		field = "date_added"
+	}
+	clause, err := orderByClause("library entries", field, UserBookSortFields, f.Descending)
+	if err != nil {
+		return nil, err
+	}
+
+	var order interface{} = clause
+	if field == "title" {
+		order = map[string]interface{}{"book": clause}
+	}
+	return []interface{}{order, map[string]interface{}{"id": "asc"}}, nil
+}
+
+// GetUserBooks fetches every shelf entry matching the filter, requesting
+// them a page at a time. It fails at the first page the API reports errors
+// for.
+func (c *Client) GetUserBooks(ctx context.Context, filter *UserBookFilter) ([]UserBook, error) {
+	userBooks, _, err := c.getUserBooks(ctx, filter, false)
+	return userBooks, err
+}
+
+// GetUserBooksPartial fetches every shelf entry matching the filter like
+// GetUserBooks, but keeps the entries the API returned alongside errors,
+// returning the errors of every page as warnings.
+func (c *Client) GetUserBooksPartial(ctx context.Context, filter *UserBookFilter) ([]UserBook, *GraphQLErrors, error) {
+	return c.getUserBooks(ctx, filter, true)
+}
+
+// getUserBooks pages through the shelf entries matching the filter. With
+// partial, the errors reported alongside each page are collected as
+// warnings; otherwise the first of them ends the fetch.
+func (c *Client) getUserBooks(
+	ctx context.Context, filter *UserBookFilter, partial bool,
+) ([]UserBook, *GraphQLErrors, error) {
+	where, err := filter.where()
+	if err != nil {
+		return nil, nil, err
+	}
+	order, err := filter.orderBy()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var warnings []GraphQLError
+	userBooks, err := collectPages(func(offset int) ([]UserBook, error) {
+		variables := map[string]interface{}{
+			"where":    where,
+			"order_by": order,
+			"limit":    pageSize,
+			"offset":   offset,
+		}
+		var response GetUserBooksResponse
+		if !partial {
+			if err := c.Execute(ctx, GetUserBooksQuery, variables, &response); err != nil {
+				return nil, err
+			}
+			return response.UserBooks, nil
+		}
+		pageWarnings, err := c.ExecutePartial(ctx, GetUserBooksQuery, variables, &response)
+		if err != nil {
+			return nil, err
+		}
+		if pageWarnings != nil {
+			warnings = append(warnings, pageWarnings.Errors...)
+		}
+		return response.UserBooks, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(warnings) > 0 {
+		return userBooks, &GraphQLErrors{Errors: warnings}, nil
+	}
+	return userBooks, nil, nil
+}
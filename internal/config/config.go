@@ -5,14 +5,28 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"gopkg.in/yaml.v3"
+
+	"hardcover-cli/internal/client"
 )
 
 // Config represents the application configuration.
 type Config struct {
-	APIKey  string `yaml:"api_key"`
-	BaseURL string `yaml:"base_url"`
+	APIKey  string      `yaml:"api_key"`
+	BaseURL string      `yaml:"base_url"`
+	Retry   RetryConfig `yaml:"retry"`
+	// RateLimit is the most requests made in a minute, with 0 for no limit.
+	RateLimit int `yaml:"rate_limit"`
+}
+
+// RetryConfig controls how failed API requests are retried. Delays are
+// written as durations such as "500ms" or "30s".
+type RetryConfig struct {
+	MaxRetries int           `yaml:"max_retries"`
+	BaseDelay  time.Duration `yaml:"base_delay"`
+	MaxDelay   time.Duration `yaml:"max_delay"`
 }
 
 const (
@@ -26,24 +40,29 @@ const (
 func DefaultConfig() *Config {
 	return &Config{
 		BaseURL: "https://api.hardcover.app/v1/graphql",
+		Retry: RetryConfig{
+			MaxRetries: 3,
+			BaseDelay:  500 * time.Millisecond,
+			MaxDelay:   30 * time.Second,
+		},
+		RateLimit: client.DefaultRateLimit,
 	}
 }
 
 // LoadConfig loads configuration from file and environment variables.
 func LoadConfig() (*Config, error) {
 	cfg := DefaultConfig()
-
-	// Environment variables override everything
-	if apiKey := os.Getenv("HARDCOVER_API_KEY"); apiKey != "" {
-		cfg.APIKey = apiKey
-		return cfg, nil
-	}
+	apiKey := os.Getenv("HARDCOVER_API_KEY")
 
 	// Try to load from config file
 	configPath, err := GetConfigPath()
 	if err != nil {
 		// If we can't get the config path, continue with default config
 		// This allows the CLI to work even if home directory is not accessible
+		if apiKey != "" {
+			cfg.APIKey = apiKey
+			return cfg, nil
+		}
 		return cfg, err
 	}
 
@@ -58,6 +77,10 @@ func LoadConfig() (*Config, error) {
 		}
 	}
 
+	// The environment variable overrides the API key, and only the API key
+	if apiKey != "" {
+		cfg.APIKey = apiKey
+	}
 	return cfg, nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,6 +18,8 @@ func TestDefaultConfig(t *testing.T) {
 	assert.NotNil(t, cfg)
 	assert.Equal(t, "https://api.hardcover.app/v1/graphql", cfg.BaseURL)
 	assert.Empty(t, cfg.APIKey)
+	assert.Equal(t, config.RetryConfig{MaxRetries: 3, BaseDelay: 500 * time.Millisecond, MaxDelay: 30 * time.Second}, cfg.Retry)
+	assert.Equal(t, 60, cfg.RateLimit)
 }
 
 func TestLoadConfig_FromEnvironment(t *testing.T) {
@@ -57,6 +60,21 @@ base_url: https://api.hardcover.app/v1/graphql`
 	assert.Equal(t, "https://api.hardcover.app/v1/graphql", cfg.BaseURL)
 }
 
+func TestLoadConfig_RetrySettings(t *testing.T) {
+	ctm := testutil.NewConfigTestManager(t)
+	defer ctm.Cleanup()
+
+	ctm.CreateConfig(&config.Config{APIKey: "test-api-key"})
+	configContent := "api_key: test-api-key\nretry:\n  max_retries: 5\n  base_delay: 2s\n"
+	require.NoError(t, os.WriteFile(ctm.GetConfigPath(), []byte(configContent), 0o600))
+
+	cfg, err := config.LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, 5, cfg.Retry.MaxRetries)
+	assert.Equal(t, 2*time.Second, cfg.Retry.BaseDelay)
+	assert.Equal(t, 30*time.Second, cfg.Retry.MaxDelay)
+}
+
 func TestLoadConfig_NoFileExists(t *testing.T) {
 	// Setup environment and temp directory
 	envMgr := testutil.NewEnvironmentManager(t)
@@ -141,6 +159,25 @@ base_url: https://api.hardcover.app/v1/graphql`
 	assert.Equal(t, envAPIKey, cfg.APIKey)
 }
 
+func TestLoadConfig_EnvironmentKeepsFileSettings(t *testing.T) {
+	envMgr := testutil.NewEnvironmentManager(t)
+	defer envMgr.Cleanup()
+	ctm := testutil.NewConfigTestManager(t)
+	defer ctm.Cleanup()
+
+	ctm.CreateConfig(&config.Config{APIKey: "file-api-key"})
+	configContent := "api_key: file-api-key\nretry:\n  max_retries: 5\n  max_delay: 1m\nrate_limit: 30\n"
+	require.NoError(t, os.WriteFile(ctm.GetConfigPath(), []byte(configContent), 0o600))
+	envMgr.SetEnv("HARDCOVER_API_KEY", "env-api-key")
+
+	cfg, err := config.LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "env-api-key", cfg.APIKey)
+	assert.Equal(t, config.RetryConfig{MaxRetries: 5, BaseDelay: 500 * time.Millisecond, MaxDelay: time.Minute},
+		cfg.Retry)
+	assert.Equal(t, 30, cfg.RateLimit)
+}
+
 func TestLoadConfig_InvalidYAML(t *testing.T) {
 	// Setup config test manager
 	ctm := testutil.NewConfigTestManager(t)
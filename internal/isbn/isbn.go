@@ -0,0 +1,141 @@
+// Package isbn validates, normalizes and converts ISBN-10 and ISBN-13 numbers.
+package isbn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// length10 is the number of characters in an ISBN-10.
+	length10 = 10
+	// length13 is the number of digits in an ISBN-13.
+	length13 = 13
+	// bookland is the EAN prefix shared by every ISBN-13 that has an ISBN-10.
+	bookland = "978"
+	// mod10 and mod11 are the checksum moduli of ISBN-13 and ISBN-10.
+	mod10 = 10
+	mod11 = 11
+)
+
+// Errors returned by Parse. They are wrapped with details of the input.
+var (
+	ErrInvalidLength    = errors.New("ISBN must have 10 or 13 digits")
+	ErrInvalidCharacter = errors.New("ISBN contains an invalid character")
+	ErrInvalidChecksum  = errors.New("ISBN check digit does not match")
+)
+
+// ISBN is a validated ISBN in both of its forms. ISBN10 is empty for
+// ISBN-13s with the 979 prefix, which have no ISBN-10 equivalent.
+type ISBN struct {
+	ISBN10 string
+	ISBN13 string
+}
+
+// String returns the ISBN-13 form.
+func (i ISBN) String() string {
+	return i.ISBN13
+}
+
+// Clean removes the hyphens and spaces commonly used to group ISBN digits
+// and upper-cases an ISBN-10 "x" check digit.
+func Clean(s string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(s)))
+}
+
+// LooksLike reports whether s has the shape of an ISBN, without checking the
+// check digit. It is used to tell ISBNs apart from other identifiers.
+func LooksLike(s string) bool {
+	cleaned := Clean(s)
+	switch len(cleaned) {
+	case length10:
+		return isDigits(cleaned[:9]) && (cleaned[9] == 'X' || isDigits(cleaned[9:]))
+	case length13:
+		return isDigits(cleaned)
+	default:
+		return false
+	}
+}
+
+// Parse validates an ISBN-10 or ISBN-13, including its check digit, and
+// returns it in both forms.
+func Parse(s string) (ISBN, error) {
+	cleaned := Clean(s)
+
+	switch len(cleaned) {
+	case length10:
+		if !isDigits(cleaned[:9]) || (cleaned[9] != 'X' && !isDigits(cleaned[9:])) {
+			return ISBN{}, fmt.Errorf("%q: %w", s, ErrInvalidCharacter)
+		}
+		if want := checkDigit10(cleaned[:9]); cleaned[9] != want {
+			return ISBN{}, fmt.Errorf("%q: %w (expected %c)", s, ErrInvalidChecksum, want)
+		}
+		return ISBN{ISBN10: cleaned, ISBN13: to13(cleaned)}, nil
+	case length13:
+		if !isDigits(cleaned) {
+			return ISBN{}, fmt.Errorf("%q: %w", s, ErrInvalidCharacter)
+		}
+		if want := checkDigit13(cleaned[:12]); cleaned[12] != want {
+			return ISBN{}, fmt.Errorf("%q: %w (expected %c)", s, ErrInvalidChecksum, want)
+		}
+		return ISBN{ISBN10: to10(cleaned), ISBN13: cleaned}, nil
+	default:
+		return ISBN{}, fmt.Errorf("%q: %w, got %d", s, ErrInvalidLength, len(cleaned))
+	}
+}
+
+// to13 converts a valid ISBN-10 to its ISBN-13 form.
+func to13(isbn10 string) string {
+	body := bookland + isbn10[:9]
+	return body + string(checkDigit13(body))
+}
+
+// to10 converts a valid ISBN-13 to its ISBN-10 form, or returns "" when the
+// ISBN-13 does not use the 978 prefix.
+func to10(isbn13 string) string {
+	if !strings.HasPrefix(isbn13, bookland) {
+		return ""
+	}
+	body := isbn13[3:12]
+	return body + string(checkDigit10(body))
+}
+
+// checkDigit10 computes the ISBN-10 check digit for nine digits.
+func checkDigit10(body string) byte {
+	sum := 0
+	for i := range body {
+		sum += int(body[i]-'0') * (length10 - i)
+	}
+	check := (mod11 - sum%mod11) % mod11
+	if check == length10 {
+		return 'X'
+	}
+	return byte('0' + check)
+}
+
+// checkDigit13 computes the ISBN-13 check digit for twelve digits.
+func checkDigit13(body string) byte {
+	sum := 0
+	for i := range body {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += int(body[i]-'0') * weight
+	}
+	return byte('0' + (mod10-sum%mod10)%mod10)
+}
+
+// isDigits reports whether s is non-empty and consists of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := range s {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
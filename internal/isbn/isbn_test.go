@@ -0,0 +1,85 @@
+package isbn_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/isbn"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  isbn.ISBN
+	}{
+		{
+			name:  "isbn-13",
+			input: "9780441013593",
+			want:  isbn.ISBN{ISBN10: "0441013597", ISBN13: "9780441013593"},
+		},
+		{
+			name:  "hyphenated isbn-13",
+			input: "978-0-441-01359-3",
+			want:  isbn.ISBN{ISBN10: "0441013597", ISBN13: "9780441013593"},
+		},
+		{
+			name:  "isbn-10",
+			input: "0441013597",
+			want:  isbn.ISBN{ISBN10: "0441013597", ISBN13: "9780441013593"},
+		},
+		{
+			name:  "isbn-10 with lower-case X check digit",
+			input: "0-8044-2957-x",
+			want:  isbn.ISBN{ISBN10: "080442957X", ISBN13: "9780804429573"},
+		},
+		{
+			name:  "979 prefix has no isbn-10",
+			input: "979-10-90636-07-1",
+			want:  isbn.ISBN{ISBN13: "9791090636071"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := isbn.Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want.ISBN13, got.String())
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+		message string
+	}{
+		{name: "too short", input: "12345", wantErr: isbn.ErrInvalidLength, message: "got 5"},
+		{name: "letters", input: "97804410135A3", wantErr: isbn.ErrInvalidCharacter},
+		{name: "X in isbn-13", input: "978044101359X", wantErr: isbn.ErrInvalidCharacter},
+		{name: "bad isbn-13 check digit", input: "9780441013590", wantErr: isbn.ErrInvalidChecksum, message: "expected 3"},
+		{name: "bad isbn-10 check digit", input: "0441013590", wantErr: isbn.ErrInvalidChecksum, message: "expected 7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := isbn.Parse(tt.input)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+}
+
+func TestLooksLike(t *testing.T) {
+	assert.True(t, isbn.LooksLike("978-0-441-01359-3"))
+	assert.True(t, isbn.LooksLike("080442957x"))
+	assert.True(t, isbn.LooksLike("9780441013590"))
+	assert.False(t, isbn.LooksLike("328491"))
+	assert.False(t, isbn.LooksLike("dune"))
+	assert.False(t, isbn.LooksLike("X804429570"))
+}
@@ -0,0 +1,140 @@
+// Package output provides pluggable renderers for presenting command results
+// as text, JSON, YAML, CSV, TSV or aligned tables.
+package output
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Format identifies an output format selectable with the --output flag.
+type Format string
+
+// Supported output formats.
+const (
+	FormatText  Format = "text"
+	FormatJSON  Format = "json"
+	FormatYAML  Format = "yaml"
+	FormatCSV   Format = "csv"
+	FormatTSV   Format = "tsv"
+	FormatTable Format = "table"
+)
+
+// Renderer writes a value to w in a particular format.
+type Renderer interface {
+	Render(w io.Writer, v interface{}) error
+}
+
+// RendererFunc adapts an ordinary function to the Renderer interface.
+type RendererFunc func(w io.Writer, v interface{}) error
+
+// Render calls f(w, v).
+func (f RendererFunc) Render(w io.Writer, v interface{}) error {
+	return f(w, v)
+}
+
+// Texter is implemented by values that know how to present themselves as
+// human-readable text.
+type Texter interface {
+	WriteText(w io.Writer) error
+}
+
+var renderers = map[Format]Renderer{
+	FormatText:  RendererFunc(renderText),
+	FormatJSON:  RendererFunc(renderJSON),
+	FormatYAML:  RendererFunc(renderYAML),
+	FormatCSV:   delimitedRenderer(','),
+	FormatTSV:   delimitedRenderer('\t'),
+	FormatTable: RendererFunc(renderTable),
+}
+
+// Register makes a renderer available under the given format name,
+// replacing any renderer previously registered for it.
+func Register(format Format, r Renderer) {
+	renderers[format] = r
+}
+
+// Formats returns the names of all registered formats in sorted order.
+func Formats() []string {
+	names := make([]string, 0, len(renderers))
+	for format := range renderers {
+		names = append(names, string(format))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseFormat validates a format name, returning an error listing the
+// supported formats when it is unknown.
+func ParseFormat(name string) (Format, error) {
+	format := Format(strings.ToLower(strings.TrimSpace(name)))
+	if format == "" {
+		return FormatText, nil
+	}
+	if _, ok := renderers[format]; !ok {
+		return "", fmt.Errorf("unsupported output format %q (supported: %s)", name, strings.Join(Formats(), ", "))
+	}
+	return format, nil
+}
+
+// Render writes v to w using the renderer registered for format.
+func Render(w io.Writer, format Format, v interface{}) error {
+	r, ok := renderers[format]
+	if !ok {
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+	return r.Render(w, v)
+}
+
+// textValue pairs structured data with a custom text presentation.
+type textValue struct {
+	data interface{}
+	text func(w io.Writer) error
+}
+
+// WriteText implements Texter.
+func (t textValue) WriteText(w io.Writer) error {
+	return t.text(w)
+}
+
+// WithText wraps data so that the text format uses the supplied function
+// while every structured format renders data itself.
+func WithText(data interface{}, text func(w io.Writer) error) interface{} {
+	return textValue{data: data, text: text}
+}
+
+// unwrap returns the structured data behind a value created by WithText.
+func unwrap(v interface{}) interface{} {
+	if t, ok := v.(textValue); ok {
+		return t.data
+	}
+	return v
+}
+
+// renderText renders values implementing Texter, falling back to a
+// "field: value" listing for anything else.
+func renderText(w io.Writer, v interface{}) error {
+	if t, ok := v.(Texter); ok {
+		return t.WriteText(w)
+	}
+
+	columns, rows := tabulate(unwrap(v))
+	for i, row := range rows {
+		if i > 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+		for j, value := range row {
+			if value == "" {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "%s: %s\n", columns[j], value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package output_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/output"
+)
+
+type testTimestamp time.Time
+
+type testAuthor struct {
+	Name string `json:"name"`
+}
+
+type testBook struct {
+	ID        int            `json:"id"`
+	Title     string         `json:"title"`
+	Tags      []string       `json:"tags"`
+	Author    *testAuthor    `json:"author"`
+	Rating    float64        `json:"rating"`
+	CreatedAt *testTimestamp `json:"created_at"`
+	Hidden    string         `json:"-"`
+}
+
+func testBooks() []testBook {
+	created := testTimestamp(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	return []testBook{
+		{ID: 1, Title: "Dune", Tags: []string{"sci-fi", "classic"}, Author: &testAuthor{Name: "Frank Herbert"},
+			Rating: 4.5, CreatedAt: &created, Hidden: "secret"},
+		{ID: 2, Title: "Emma, A Novel"},
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    output.Format
+		wantErr bool
+	}{
+		{"", output.FormatText, false},
+		{"json", output.FormatJSON, false},
+		{"YAML", output.FormatYAML, false},
+		{" table ", output.FormatTable, false},
+		{"xml", "", true},
+	}
+	for _, tt := range tests {
+		got, err := output.ParseFormat(tt.in)
+		if tt.wantErr {
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "supported: csv, json, table, text, tsv, yaml")
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+}
+
+func TestRender_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	err := output.Render(&buf, output.FormatJSON, output.WithText(testBooks(), func(io.Writer) error {
+		t.Fatal("text function must not be used for JSON")
+		return nil
+	}))
+	require.NoError(t, err)
+
+	var decoded []map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Len(t, decoded, 2)
+	assert.Equal(t, "Dune", decoded[0]["title"])
+	assert.NotContains(t, decoded[0], "Hidden")
+}
+
+func TestRender_YAML(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, output.Render(&buf, output.FormatYAML, testBook{ID: 7, Title: "123"}))
+
+	assert.Equal(t, "id: 7\ntitle: \"123\"\ntags: null\nauthor: null\nrating: 0\ncreated_at: null\n", buf.String())
+}
+
+func TestRender_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, output.Render(&buf, output.FormatCSV, testBooks()))
+
+	assert.Equal(t,
+		"id,title,tags,author.name,rating,created_at\n"+
+			"1,Dune,sci-fi; classic,Frank Herbert,4.5,2024-01-02T03:04:05Z\n"+
+			"2,\"Emma, A Novel\",,,0,\n",
+		buf.String())
+}
+
+func TestRender_TSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, output.Render(&buf, output.FormatTSV, &testBook{ID: 3, Title: "Emma"}))
+
+	assert.Equal(t, "id\ttitle\ttags\tauthor.name\trating\tcreated_at\n3\tEmma\t\t\t0\t\n", buf.String())
+}
+
+func TestRender_Table(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, output.Render(&buf, output.FormatTable, testBooks()))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 3)
+	assert.True(t, bytes.HasPrefix(lines[0], []byte("ID  TITLE          TAGS")))
+	assert.Contains(t, string(lines[1]), "Frank Herbert")
+}
+
+func TestRender_TextUsesTexter(t *testing.T) {
+	var buf bytes.Buffer
+	err := output.Render(&buf, output.FormatText, output.WithText(testBooks(), func(w io.Writer) error {
+		_, err := io.WriteString(w, "custom text\n")
+		return err
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "custom text\n", buf.String())
+}
+
+func TestRender_TextFallback(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, output.Render(&buf, output.FormatText, testBook{ID: 9, Title: "Persuasion"}))
+	assert.Equal(t, "id: 9\ntitle: Persuasion\nrating: 0\n", buf.String())
+}
+
+func TestRender_Scalars(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, output.Render(&buf, output.FormatCSV, []string{"a", "b"}))
+	assert.Equal(t, "value\na\nb\n", buf.String())
+}
+
+func TestRegister(t *testing.T) {
+	const custom output.Format = "upper"
+	output.Register(custom, output.RendererFunc(func(w io.Writer, _ interface{}) error {
+		_, err := io.WriteString(w, "CUSTOM")
+		return err
+	}))
+
+	format, err := output.ParseFormat("upper")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, output.Render(&buf, format, nil))
+	assert.Equal(t, "CUSTOM", buf.String())
+}
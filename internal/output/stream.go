@@ -0,0 +1,167 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"text/tabwriter"
+)
+
+// Stream writes results incrementally as batches arrive, producing the same
+// document a single Render call would for the combined batches. Batches are
+// usually slices; each call to Write appends their elements.
+//
+// JSON, YAML, CSV, TSV and text output are written as each batch arrives.
+// Table output is buffered until Close so columns stay aligned across
+// batches. Custom formats render each batch independently.
+type Stream struct {
+	w      io.Writer
+	format Format
+	items  int
+	csv    *csv.Writer
+	table  *tabwriter.Writer
+}
+
+// NewStream creates a stream writing to w in the given format.
+func NewStream(w io.Writer, format Format) (*Stream, error) {
+	if _, ok := renderers[format]; !ok {
+		return nil, fmt.Errorf("unsupported output format %q", format)
+	}
+	return &Stream{w: w, format: format}, nil
+}
+
+// Format returns the format the stream writes.
+func (s *Stream) Format() Format {
+	return s.format
+}
+
+// Items returns the number of elements written so far.
+func (s *Stream) Items() int {
+	return s.items
+}
+
+// Write appends a batch of results to the stream.
+func (s *Stream) Write(batch interface{}) error {
+	elems := elements(unwrap(batch))
+	defer func() { s.items += len(elems) }()
+
+	switch s.format {
+	case FormatJSON:
+		return s.writeJSON(elems)
+	case FormatYAML:
+		if len(elems) == 0 {
+			return nil
+		}
+		return renderYAML(s.w, unwrap(batch))
+	case FormatCSV, FormatTSV:
+		return s.writeDelimited(batch)
+	case FormatTable:
+		return s.writeTable(batch)
+	case FormatText:
+		return renderText(s.w, batch)
+	default:
+		return Render(s.w, s.format, batch)
+	}
+}
+
+// Close terminates the document, writing any buffered output.
+func (s *Stream) Close() error {
+	switch s.format {
+	case FormatJSON:
+		if s.items == 0 {
+			_, err := io.WriteString(s.w, "[]\n")
+			return err
+		}
+		_, err := io.WriteString(s.w, "\n]\n")
+		return err
+	case FormatYAML:
+		if s.items == 0 {
+			_, err := io.WriteString(s.w, "[]\n")
+			return err
+		}
+	case FormatCSV, FormatTSV:
+		if s.csv != nil {
+			s.csv.Flush()
+			return s.csv.Error()
+		}
+	case FormatTable:
+		if s.table != nil {
+			return s.table.Flush()
+		}
+	case FormatText:
+	}
+	return nil
+}
+
+// writeJSON writes each element as a member of a single top-level array.
+func (s *Stream) writeJSON(elems []interface{}) error {
+	for i, elem := range elems {
+		data, err := json.MarshalIndent(elem, "  ", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode JSON: %w", err)
+		}
+
+		separator := ",\n  "
+		if s.items+i == 0 {
+			separator = "[\n  "
+		}
+		if _, err := io.WriteString(s.w, separator); err != nil {
+			return err
+		}
+		if _, err := s.w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeDelimited writes the header with the first batch and rows for every
+// batch.
+func (s *Stream) writeDelimited(batch interface{}) error {
+	columns, rows := tabulate(batch)
+	if s.csv == nil {
+		s.csv = csv.NewWriter(s.w)
+		if s.format == FormatTSV {
+			s.csv.Comma = '\t'
+		}
+		if err := s.csv.Write(columns); err != nil {
+			return fmt.Errorf("failed to write header: %w", err)
+		}
+	}
+	if err := s.csv.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write rows: %w", err)
+	}
+	return nil
+}
+
+// writeTable buffers rows in a shared tabwriter so that alignment spans all
+// batches.
+func (s *Stream) writeTable(batch interface{}) error {
+	columns, rows := tabulate(batch)
+	if s.table == nil {
+		s.table = tabwriter.NewWriter(s.w, 0, 0, tablePadding, ' ', 0)
+		if err := writeTableHeader(s.table, columns); err != nil {
+			return err
+		}
+	}
+	return writeTableRows(s.table, rows)
+}
+
+// elements returns the members of a slice or array, or v itself otherwise.
+func elements(v interface{}) []interface{} {
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return nil
+	}
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return []interface{}{v}
+	}
+
+	elems := make([]interface{}, value.Len())
+	for i := range elems {
+		elems[i] = value.Index(i).Interface()
+	}
+	return elems
+}
@@ -0,0 +1,96 @@
+package output_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"hardcover-cli/internal/output"
+)
+
+type streamItem struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func writeBatches(t *testing.T, format output.Format, batches ...[]streamItem) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	stream, err := output.NewStream(&buf, format)
+	require.NoError(t, err)
+	for _, batch := range batches {
+		require.NoError(t, stream.Write(batch))
+	}
+	require.NoError(t, stream.Close())
+	return buf.String()
+}
+
+func TestStream_JSONProducesSingleArray(t *testing.T) {
+	out := writeBatches(t, output.FormatJSON,
+		[]streamItem{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}},
+		[]streamItem{{ID: 3, Name: "c"}},
+	)
+
+	var decoded []streamItem
+	require.NoError(t, json.Unmarshal([]byte(out), &decoded))
+	assert.Equal(t, []streamItem{{1, "a"}, {2, "b"}, {3, "c"}}, decoded)
+}
+
+func TestStream_EmptyDocuments(t *testing.T) {
+	assert.Equal(t, "[]\n", writeBatches(t, output.FormatJSON, []streamItem{}))
+	assert.Equal(t, "[]\n", writeBatches(t, output.FormatYAML, []streamItem{}))
+	assert.Equal(t, "id,name\n", writeBatches(t, output.FormatCSV, []streamItem{}))
+}
+
+func TestStream_YAMLConcatenatesSequence(t *testing.T) {
+	out := writeBatches(t, output.FormatYAML,
+		[]streamItem{{ID: 1, Name: "a"}},
+		[]streamItem{},
+		[]streamItem{{ID: 2, Name: "b"}},
+	)
+	assert.Equal(t, "- id: 1\n  name: a\n- id: 2\n  name: b\n", out)
+}
+
+func TestStream_CSVWritesHeaderOnce(t *testing.T) {
+	out := writeBatches(t, output.FormatTSV,
+		[]streamItem{{ID: 1, Name: "a"}},
+		[]streamItem{{ID: 2, Name: "b"}},
+	)
+	assert.Equal(t, "id\tname\n1\ta\n2\tb\n", out)
+}
+
+func TestStream_TableAlignsAcrossBatches(t *testing.T) {
+	out := writeBatches(t, output.FormatTable,
+		[]streamItem{{ID: 1, Name: "a"}},
+		[]streamItem{{ID: 200, Name: "b"}},
+	)
+	assert.Equal(t, "ID   NAME\n1    a\n200  b\n", out)
+}
+
+func TestStream_TextUsesTexter(t *testing.T) {
+	var buf bytes.Buffer
+	stream, err := output.NewStream(&buf, output.FormatText)
+	require.NoError(t, err)
+
+	for _, batch := range []string{"first\n", "second\n"} {
+		text := batch
+		require.NoError(t, stream.Write(output.WithText([]string{text}, func(w io.Writer) error {
+			_, writeErr := io.WriteString(w, text)
+			return writeErr
+		})))
+	}
+	require.NoError(t, stream.Close())
+
+	assert.Equal(t, "first\nsecond\n", buf.String())
+	assert.Equal(t, 2, stream.Items())
+}
+
+func TestNewStream_UnknownFormat(t *testing.T) {
+	_, err := output.NewStream(io.Discard, "xml")
+	require.Error(t, err)
+}
@@ -0,0 +1,53 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+const yamlIndent = 2
+
+// renderJSON writes v as indented JSON using the json tags of the client
+// response types as field names.
+func renderJSON(w io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(unwrap(v)); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+	return nil
+}
+
+// renderYAML writes v as YAML. The value is first encoded as JSON so that
+// YAML output shares the same field names and ordering as the JSON format.
+func renderYAML(w io.Writer, v interface{}) error {
+	data, err := json.Marshal(unwrap(v))
+	if err != nil {
+		return fmt.Errorf("failed to encode YAML: %w", err)
+	}
+
+	var node yaml.Node
+	if unmarshalErr := yaml.Unmarshal(data, &node); unmarshalErr != nil {
+		return fmt.Errorf("failed to encode YAML: %w", unmarshalErr)
+	}
+	resetStyle(&node)
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(yamlIndent)
+	if encodeErr := encoder.Encode(&node); encodeErr != nil {
+		return fmt.Errorf("failed to encode YAML: %w", encodeErr)
+	}
+	return encoder.Close()
+}
+
+// resetStyle clears the flow style inherited from the JSON source so the
+// encoder emits block-style YAML.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
@@ -0,0 +1,284 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const tablePadding = 2
+
+// Tabular is implemented by values that provide their own column layout for
+// the csv, tsv and table formats.
+type Tabular interface {
+	Columns() []string
+	Rows() [][]string
+}
+
+// delimitedRenderer returns a renderer writing delimiter-separated values
+// with a header row.
+func delimitedRenderer(delimiter rune) Renderer {
+	return RendererFunc(func(w io.Writer, v interface{}) error {
+		columns, rows := tabulate(v)
+		writer := csv.NewWriter(w)
+		writer.Comma = delimiter
+		if err := writer.Write(columns); err != nil {
+			return fmt.Errorf("failed to write header: %w", err)
+		}
+		if err := writer.WriteAll(rows); err != nil {
+			return fmt.Errorf("failed to write rows: %w", err)
+		}
+		return nil
+	})
+}
+
+// renderTable writes v as whitespace-aligned columns with an upper-case
+// header row.
+func renderTable(w io.Writer, v interface{}) error {
+	columns, rows := tabulate(v)
+	tw := tabwriter.NewWriter(w, 0, 0, tablePadding, ' ', 0)
+	if err := writeTableHeader(tw, columns); err != nil {
+		return err
+	}
+	if err := writeTableRows(tw, rows); err != nil {
+		return err
+	}
+	return tw.Flush()
+}
+
+// writeTableHeader writes the upper-cased column names.
+func writeTableHeader(tw *tabwriter.Writer, columns []string) error {
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = strings.ToUpper(column)
+	}
+	_, err := fmt.Fprintln(tw, strings.Join(header, "\t"))
+	return err
+}
+
+// writeTableRows writes rows, collapsing whitespace within each cell so that
+// multi-line values do not break the layout.
+func writeTableRows(tw *tabwriter.Writer, rows [][]string) error {
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = strings.Join(strings.Fields(cell), " ")
+		}
+		if _, err := fmt.Fprintln(tw, strings.Join(cells, "\t")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldPath locates a (possibly nested) struct field and the column name
+// used for it.
+type fieldPath struct {
+	name  string
+	index []int
+}
+
+// tabulate converts v into a header and rows. Structs produce a single row,
+// slices produce one row per element and column names are taken from the
+// json tags of the underlying type, with nested structs flattened using
+// dotted names.
+func tabulate(v interface{}) ([]string, [][]string) {
+	v = unwrap(v)
+	if t, ok := v.(Tabular); ok {
+		return t.Columns(), t.Rows()
+	}
+
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil, nil
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return nil, nil
+	}
+
+	var elems []reflect.Value
+	elemType := value.Type()
+	if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+		elemType = indirectType(elemType.Elem())
+		for i := 0; i < value.Len(); i++ {
+			elems = append(elems, value.Index(i))
+		}
+	} else {
+		elems = append(elems, value)
+	}
+
+	if elemType.Kind() != reflect.Struct || isLeafType(elemType) {
+		rows := make([][]string, 0, len(elems))
+		for _, elem := range elems {
+			rows = append(rows, []string{formatValue(elem)})
+		}
+		return []string{"value"}, rows
+	}
+
+	fields := structFields(elemType, "", nil, map[reflect.Type]bool{})
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		columns[i] = field.name
+	}
+
+	rows := make([][]string, 0, len(elems))
+	for _, elem := range elems {
+		row := make([]string, len(fields))
+		for i, field := range fields {
+			row[i] = formatValue(fieldByIndex(elem, field.index))
+		}
+		rows = append(rows, row)
+	}
+	return columns, rows
+}
+
+// structFields lists the exported fields of t, flattening nested structs.
+// Types already being expanded are treated as leaves to avoid recursing
+// through self-referencing API types.
+func structFields(t reflect.Type, prefix string, index []int, seen map[reflect.Type]bool) []fieldPath {
+	seen[t] = true
+	defer delete(seen, t)
+
+	var fields []fieldPath
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := jsonName(field)
+		if name == "" {
+			continue
+		}
+
+		fieldIndex := append(append([]int{}, index...), i)
+		fieldType := indirectType(field.Type)
+		if fieldType.Kind() == reflect.Struct && !isLeafType(fieldType) && !seen[fieldType] {
+			fields = append(fields, structFields(fieldType, prefix+name+".", fieldIndex, seen)...)
+			continue
+		}
+		fields = append(fields, fieldPath{name: prefix + name, index: fieldIndex})
+	}
+	return fields
+}
+
+// jsonName returns the name used for field in JSON output, or "" when the
+// field is excluded from JSON.
+func jsonName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name
+	}
+	return field.Name
+}
+
+// fieldByIndex walks index from v, returning an invalid value when a nil
+// pointer is encountered along the way.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v
+}
+
+// indirectType strips pointer indirections from t.
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// isLeafType reports whether t should be rendered as a single cell even
+// though it is a struct, as is the case for the time-based scalar types.
+func isLeafType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.ConvertibleTo(timeType)
+}
+
+// formatValue renders a single cell.
+func formatValue(v reflect.Value) string {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return ""
+	}
+
+	if isLeafType(v.Type()) {
+		t, ok := v.Convert(timeType).Interface().(time.Time)
+		if !ok || t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+	if v.Type() == rawMessageType {
+		return string(v.Bytes())
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Slice, reflect.Array:
+		if isScalarKind(indirectType(v.Type().Elem()).Kind()) {
+			parts := make([]string, 0, v.Len())
+			for i := 0; i < v.Len(); i++ {
+				parts = append(parts, formatValue(v.Index(i)))
+			}
+			return strings.Join(parts, "; ")
+		}
+	case reflect.Invalid, reflect.Complex64, reflect.Complex128, reflect.Chan, reflect.Func,
+		reflect.Interface, reflect.Map, reflect.Ptr, reflect.Struct, reflect.UnsafePointer:
+	}
+
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprint(v.Interface())
+	}
+	return string(data)
+}
+
+// isScalarKind reports whether values of kind k render as plain text.
+func isScalarKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}